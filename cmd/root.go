@@ -2,23 +2,55 @@ package cmd
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/anirudhraja/gqllinter/pkg/linter"
 	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
 	"github.com/spf13/cobra"
 )
 
+// stdinSourceName is the file name reported for schemas read from standard input
+const stdinSourceName = "<stdin>"
+
+// verbosityLevel controls how much is printed besides the errors themselves
+type verbosityLevel int
+
+const (
+	// verbosityQuiet prints only error lines
+	verbosityQuiet verbosityLevel = iota
+	// verbosityNormal also prints the summary
+	verbosityNormal
+	// verbosityVerbose also prints which rules ran and how many files were scanned
+	verbosityVerbose
+)
+
 var (
 	configFile     string
 	format         string
 	outputFile     string
 	rules          []string
+	only           []string
+	except         []string
 	ignorePragma   string
 	customRulesDir string
+	jobs           int
+	fix            bool
+	stdin          bool
+	profile        bool
+	cacheDir       string
+	quiet          bool
+	verbose        bool
+	listRules      bool
+	now            string
 )
 
 var rootCmd = &cobra.Command{
@@ -30,8 +62,18 @@ based on Yelp's GraphQL guidelines and other industry standards.
 Examples:
   gqllinter schema.graphql
   gqllinter --format json --output results.json schema/*.graphql
-  gqllinter --rules types-have-descriptions,fields-have-descriptions schema.graphql`,
-	Args: cobra.MinimumNArgs(1),
+  gqllinter --rules types-have-descriptions,fields-have-descriptions schema.graphql
+  gqllinter --only naming-convention schema.graphql
+  gqllinter --except alphabetize --except relay-pageinfo schema/*.graphql
+  gqllinter --fix schema/*.graphql
+  cat schema.graphql | gqllinter --stdin
+  gqllinter --list-rules`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && !stdin && !listRules {
+			return fmt.Errorf("requires at least one schema file, or --stdin")
+		}
+		return nil
+	},
 	RunE: runLint,
 }
 
@@ -41,14 +83,46 @@ func Execute() error {
 
 func init() {
 	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "path to configuration file")
-	rootCmd.PersistentFlags().StringVar(&format, "format", "text", "output format (text, json)")
+	rootCmd.PersistentFlags().StringVar(&format, "format", "text", "output format (text, json, checkstyle, github); defaults to github when GITHUB_ACTIONS is set")
 	rootCmd.PersistentFlags().StringVar(&outputFile, "output", "", "output file (default: stdout)")
 	rootCmd.PersistentFlags().StringSliceVar(&rules, "rules", []string{}, "comma-separated list of rules to run")
+	rootCmd.PersistentFlags().StringSliceVar(&only, "only", []string{}, "run only this rule (repeatable)")
+	rootCmd.PersistentFlags().StringSliceVar(&except, "except", []string{}, "run every default rule except this one (repeatable)")
+	rootCmd.MarkFlagsMutuallyExclusive("rules", "only", "except")
 	rootCmd.PersistentFlags().StringVar(&ignorePragma, "ignore", "# gqllinter-ignore", "comment to ignore linting errors")
 	rootCmd.PersistentFlags().StringVar(&customRulesDir, "custom-rule-paths", "", "path to custom rules directory")
+	rootCmd.PersistentFlags().IntVar(&jobs, "jobs", runtime.NumCPU(), "number of rules to run concurrently")
+	rootCmd.PersistentFlags().BoolVar(&fix, "fix", false, "automatically fix problems for rules that support it")
+	rootCmd.PersistentFlags().StringVar(&cacheDir, "cache-dir", "", "directory for caching lint results of unchanged files")
+	rootCmd.PersistentFlags().BoolVar(&profile, "profile", false, "print the time spent in each rule to stderr")
+	rootCmd.PersistentFlags().BoolVar(&stdin, "stdin", false, "read the schema from standard input (same as passing - as the path)")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "print only error lines")
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "also print which rules ran and how many files were scanned")
+	rootCmd.MarkFlagsMutuallyExclusive("quiet", "verbose")
+	rootCmd.PersistentFlags().StringVar(&now, "now", "", "date (YYYY-MM-DD) that date-dependent rules compare against (default: today)")
+	rootCmd.PersistentFlags().BoolVar(&listRules, "list-rules", false, "print the name and description of every available rule and exit")
 }
 
 func runLint(cmd *cobra.Command, args []string) error {
+	if listRules {
+		return printRules()
+	}
+
+	// Emit PR annotations when running inside GitHub Actions, unless a format was chosen
+	if !cmd.Flags().Changed("format") && os.Getenv("GITHUB_ACTIONS") == "true" {
+		format = "github"
+	}
+
+	// A "-" path reads the schema from standard input
+	for _, arg := range args {
+		if arg == "-" {
+			stdin = true
+		}
+	}
+	if stdin {
+		return lintStdin(cmd, args)
+	}
+
 	// Expand glob patterns in arguments
 	var schemaFiles []string
 	for _, pattern := range args {
@@ -63,33 +137,235 @@ func runLint(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("no schema files found")
 	}
 
+	l, err := newLinter()
+	if err != nil {
+		return err
+	}
+
+	// Fix first so only the problems that remain are reported
+	if fix {
+		for _, file := range schemaFiles {
+			if _, err := l.FixFile(file); err != nil {
+				return fmt.Errorf("failed to fix %s: %w", file, err)
+			}
+		}
+	}
+
+	// Lint all schema files together so rules can check across files
+	allErrors, err := l.LintFiles(schemaFiles)
+	if err != nil {
+		return fmt.Errorf("failed to lint: %w", err)
+	}
+	printProfile(l)
+	printScanSummary(l, len(schemaFiles))
+
+	// Output results
+	return outputResults(allErrors)
+}
+
+// lintStdin lints a schema read from standard input
+func lintStdin(cmd *cobra.Command, args []string) error {
+	for _, arg := range args {
+		if arg != "-" {
+			return fmt.Errorf("cannot lint standard input together with schema files")
+		}
+	}
+	if fix {
+		return fmt.Errorf("--fix cannot be used with standard input")
+	}
+
+	content, err := io.ReadAll(cmd.InOrStdin())
+	if err != nil {
+		return fmt.Errorf("failed to read standard input: %w", err)
+	}
+
+	l, err := newLinter()
+	if err != nil {
+		return err
+	}
+
+	// Standard input holds the whole schema, so cross-file rules such as require-query-type run on it too
+	errors, err := l.LintSources([]*ast.Source{{Name: stdinSourceName, Input: string(content)}})
+	if err != nil {
+		return fmt.Errorf("failed to lint %s: %w", stdinSourceName, err)
+	}
+	printProfile(l)
+	printScanSummary(l, 1)
+
+	return outputResults(errors)
+}
+
+// newLinter creates a linter configured from the command line flags
+func newLinter() (*linter.Linter, error) {
 	// Create linter instance
 	l := linter.New()
 
 	// Load custom rules if specified
 	if customRulesDir != "" {
 		if err := l.LoadCustomRules(customRulesDir); err != nil {
-			return fmt.Errorf("failed to load custom rules: %w", err)
+			return nil, fmt.Errorf("failed to load custom rules: %w", err)
 		}
 	}
 
+	l.SetJobs(jobs)
+
 	// Set specific rules if provided
-	if len(rules) > 0 {
+	if err := selectRules(l); err != nil {
+		return nil, err
+	}
+
+	l.SetProfiling(profile)
+	l.SetCacheDir(cacheDir)
+
+	if now != "" {
+		date, err := time.Parse("2006-01-02", now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --now date %q: expected YYYY-MM-DD", now)
+		}
+		l.SetNow(date)
+	}
+
+	return l, nil
+}
+
+// selectRules enables the rules chosen with --rules, --only or --except
+func selectRules(l *linter.Linter) error {
+	switch {
+	case len(only) > 0:
+		if err := checkRuleNames(l, "--only", only); err != nil {
+			return err
+		}
+		l.SetRules(only)
+	case len(except) > 0:
+		if err := checkRuleNames(l, "--except", except); err != nil {
+			return err
+		}
+		excluded := make(map[string]bool)
+		for _, name := range except {
+			excluded[name] = true
+		}
+		var selected []string
+		for _, name := range l.GetActiveRules() {
+			if !excluded[name] {
+				selected = append(selected, name)
+			}
+		}
+		if len(selected) == 0 {
+			return fmt.Errorf("--except excludes every rule")
+		}
+		l.SetRules(selected)
+	case len(rules) > 0:
 		l.SetRules(rules)
 	}
+	return nil
+}
+
+// checkRuleNames returns an error listing the names that are not available rules
+func checkRuleNames(l *linter.Linter, flag string, names []string) error {
+	available := make(map[string]bool)
+	for _, name := range l.GetAvailableRules() {
+		available[name] = true
+	}
 
-	// Lint all schema files
-	var allErrors []types.LintError
-	for _, file := range schemaFiles {
-		errors, err := l.LintFile(file)
+	var unknown []string
+	for _, name := range names {
+		if !available[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown rules for %s: %s; run --list-rules to see available rules", flag, strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// printRules prints every available rule, including custom rules, in the selected format
+func printRules() error {
+	l, err := newLinter()
+	if err != nil {
+		return err
+	}
+	infos := l.GetRuleInfos()
+
+	var output string
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(struct {
+			Rules []linter.RuleInfo `json:"rules"`
+		}{Rules: infos}, "", "  ")
 		if err != nil {
-			return fmt.Errorf("failed to lint %s: %w", file, err)
+			return err
 		}
-		allErrors = append(allErrors, errors...)
+		output = string(data) + "\n"
+	case "text":
+		output = formatRules(infos)
+	default:
+		return fmt.Errorf("unsupported format for --list-rules: %s", format)
 	}
 
-	// Output results
-	return outputResults(allErrors)
+	if outputFile != "" {
+		return os.WriteFile(outputFile, []byte(output), 0644)
+	}
+
+	fmt.Print(output)
+	return nil
+}
+
+// formatRules renders rules as a table of names and descriptions
+func formatRules(infos []linter.RuleInfo) string {
+	var builder strings.Builder
+	w := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "RULE\tDESCRIPTION")
+	for _, info := range infos {
+		fmt.Fprintf(w, "%s\t%s\n", info.Name, info.Description)
+	}
+	_ = w.Flush()
+	return builder.String()
+}
+
+// printProfile writes the per-rule timings to stderr when --profile is set
+func printProfile(l *linter.Linter) {
+	if !profile {
+		return
+	}
+	fmt.Fprint(os.Stderr, formatProfile(l.Profile()))
+}
+
+// formatProfile renders rule profiles as a table, slowest rule first
+func formatProfile(profiles []linter.RuleProfile) string {
+	var builder strings.Builder
+	w := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "RULE\tDURATION\tERRORS")
+	for _, p := range profiles {
+		fmt.Fprintf(w, "%s\t%s\t%d\n", p.Rule, p.Duration, p.Errors)
+	}
+	_ = w.Flush()
+	return builder.String()
+}
+
+// verbosity returns the output verbosity selected by --quiet and --verbose
+func verbosity() verbosityLevel {
+	switch {
+	case quiet:
+		return verbosityQuiet
+	case verbose:
+		return verbosityVerbose
+	default:
+		return verbosityNormal
+	}
+}
+
+// printScanSummary writes the rules that ran and the number of scanned files to stderr when --verbose is set
+func printScanSummary(l *linter.Linter, fileCount int) {
+	if verbosity() < verbosityVerbose {
+		return
+	}
+	fmt.Fprint(os.Stderr, formatScanSummary(l.GetActiveRules(), fileCount))
+}
+
+// formatScanSummary renders the rules that ran and the number of scanned files
+func formatScanSummary(ruleNames []string, fileCount int) string {
+	return fmt.Sprintf("Ran %d rules: %s\nScanned %d files.\n", len(ruleNames), strings.Join(ruleNames, ", "), fileCount)
 }
 
 func outputResults(errors []types.LintError) error {
@@ -100,7 +376,15 @@ func outputResults(errors []types.LintError) error {
 	case "json":
 		output, err = formatJSON(errors)
 	case "text":
-		output = formatText(errors)
+		output = formatText(errors, verbosity())
+	case "checkstyle":
+		output, err = formatCheckstyle(errors)
+	case "github":
+		output = formatGitHub(errors)
+		// Keep the human-readable summary visible in the job log
+		if verbosity() > verbosityQuiet {
+			fmt.Fprint(os.Stderr, formatText(errors, verbosity()))
+		}
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
@@ -132,8 +416,88 @@ func formatJSON(errors []types.LintError) (string, error) {
 	return string(data), nil
 }
 
-func formatText(errors []types.LintError) string {
+// checkstyleReport is the root element of a Checkstyle XML report
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+// checkstyleFile groups the errors reported for a single file
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+// checkstyleError is a single error in a Checkstyle XML report
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+func formatCheckstyle(errors []types.LintError) (string, error) {
+	report := checkstyleReport{Version: "4.3"}
+
+	// Errors are sorted by file, so each file's errors are contiguous
+	for _, lintErr := range errors {
+		if len(report.Files) == 0 || report.Files[len(report.Files)-1].Name != lintErr.Location.File {
+			report.Files = append(report.Files, checkstyleFile{Name: lintErr.Location.File})
+		}
+		file := &report.Files[len(report.Files)-1]
+		file.Errors = append(file.Errors, checkstyleError{
+			Line:     lintErr.Location.Line,
+			Column:   lintErr.Location.Column,
+			Severity: "error",
+			Message:  lintErr.Message,
+			Source:   lintErr.Rule,
+		})
+	}
+
+	data, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return xml.Header + string(data) + "\n", nil
+}
+
+// formatGitHub renders errors as GitHub Actions workflow commands, which show up as PR annotations
+func formatGitHub(errors []types.LintError) string {
+	var builder strings.Builder
+	for _, err := range errors {
+		fmt.Fprintf(&builder, "::error file=%s,line=%d,col=%d",
+			escapeGitHubProperty(err.Location.File),
+			err.Location.Line,
+			err.Location.Column,
+		)
+		// Underline the whole span when the rule reported one
+		if err.Location.EndLine > 0 {
+			fmt.Fprintf(&builder, ",endLine=%d,endColumn=%d", err.Location.EndLine, err.Location.EndColumn)
+		}
+		fmt.Fprintf(&builder, "::%s\n", escapeGitHubData(fmt.Sprintf("%s (%s)", err.Message, err.Rule)))
+	}
+	return builder.String()
+}
+
+// escapeGitHubData escapes a workflow command message
+func escapeGitHubData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeGitHubProperty escapes a workflow command property value
+func escapeGitHubProperty(s string) string {
+	return strings.NewReplacer(":", "%3A", ",", "%2C").Replace(escapeGitHubData(s))
+}
+
+// formatText renders errors one per line; the summary is left out at quiet verbosity
+func formatText(errors []types.LintError, level verbosityLevel) string {
 	if len(errors) == 0 {
+		if level == verbosityQuiet {
+			return ""
+		}
 		return "No linting errors found.\n"
 	}
 
@@ -11,6 +11,9 @@ import (
 // FieldIdSuffixRule checks that ID fields end with 'ID' not 'Id'
 type FieldIdSuffixRule struct{}
 
+// PluginAPIVersion declares the linter plugin API version this plugin targets
+var PluginAPIVersion = 1
+
 // NewRule is the required entry point for plugins
 func NewRule() types.Rule {
 	return &FieldIdSuffixRule{}
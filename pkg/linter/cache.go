@@ -0,0 +1,103 @@
+package linter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/nishant-rn/gqlparser/v2/ast"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+)
+
+// cacheVersion is part of every cache key so that changes to the entry format invalidate old entries
+const cacheVersion = "1"
+
+// SetCacheDir enables caching lint results on disk in dir. Results are keyed by the
+// source name and contents and the set of active rules, so a change to any of them is
+// a cache miss. When a date-dependent rule is active the current date is part of the
+// key as well. An empty dir disables caching.
+//
+// Rule implementations are not part of the key: clear the cache after upgrading the
+// linter or changing custom rule plugins.
+func (l *Linter) SetCacheDir(dir string) {
+	l.cacheDir = dir
+}
+
+// cacheKey returns the cache key for linting source with the active rules
+func (l *Linter) cacheKey(source *ast.Source) string {
+	var names []string
+	dated := false
+	for _, rule := range l.activeRules() {
+		names = append(names, rule.Name())
+		if _, ok := rule.(types.DatedRule); ok {
+			dated = true
+		}
+	}
+	sort.Strings(names)
+
+	// Results of date-dependent rules change from one day to the next
+	if dated {
+		now := l.now
+		if now.IsZero() {
+			now = time.Now()
+		}
+		names = append(names, now.Format("2006-01-02"))
+	}
+
+	hash := sha256.New()
+	for _, part := range append([]string{cacheVersion, source.Name, source.Input}, names...) {
+		// Quote each part so that different splits can't produce the same hash
+		_ = json.NewEncoder(hash).Encode(part)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// loadCached returns the cached errors for key, if there is a readable entry
+func (l *Linter) loadCached(key string) ([]types.LintError, bool) {
+	data, err := os.ReadFile(filepath.Join(l.cacheDir, key+".json"))
+	if err != nil {
+		return nil, false
+	}
+
+	var errors []types.LintError
+	if err := json.Unmarshal(data, &errors); err != nil {
+		return nil, false
+	}
+	return errors, true
+}
+
+// storeCached writes errors to the cache entry for key. The cache is best-effort,
+// so failures only mean the next run lints the source again.
+func (l *Linter) storeCached(key string, errors []types.LintError) {
+	if errors == nil {
+		errors = []types.LintError{}
+	}
+	data, err := json.Marshal(errors)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(l.cacheDir, 0755); err != nil {
+		return
+	}
+
+	// Write to a temporary file and rename it so concurrent runs never read partial entries
+	tmp, err := os.CreateTemp(l.cacheDir, key+".*.tmp")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(l.cacheDir, key+".json")); err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
@@ -5,6 +5,10 @@ import (
 	"os"
 	"path/filepath"
 	"plugin"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/nishant-rn/gqlparser/v2"
 	"github.com/nishant-rn/gqlparser/v2/ast"
@@ -13,10 +17,35 @@ import (
 	"github.com/anirudhraja/gqllinter/pkg/types"
 )
 
-// Linter provides GraphQL schema linting functionality
+// Linter provides GraphQL schema linting functionality.
+//
+// Rules run concurrently against the same parsed schema, so a rule's Check
+// must treat the *ast.Schema and *ast.Source it receives as read-only.
 type Linter struct {
 	rules        []types.Rule
 	enabledRules map[string]bool
+	jobs         int
+	cacheDir     string
+	now          time.Time
+
+	// profiles accumulates per-rule timings when profiling is enabled
+	profiling bool
+	profileMu sync.Mutex
+	profiles  map[string]*RuleProfile
+}
+
+// RuleProfile is the time a rule spent checking schemas and the number of errors it reported
+type RuleProfile struct {
+	Rule     string
+	Duration time.Duration
+	Errors   int
+}
+
+// RuleInfo describes an available rule
+type RuleInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	OptIn       bool   `json:"optIn"`
 }
 
 // New creates a new linter instance with all built-in rules
@@ -66,8 +95,90 @@ func New() *Linter {
 			rules.NewRelayArguments(),
 			rules.NewRelayConnectionTypes(),
 			rules.NewCommonSchemaRules(),
+			rules.NewMutationNoQueryReturn(false),
+			rules.NewMutationVerbPrefix(nil),
+			rules.NewDomainScalarConsistency(nil),
+			rules.NewSingleEntityQueryNeedsArg(nil),
+			rules.NewAbstractEntityFieldsNode(),
+			rules.NewNoRequiredRecursiveInput(),
+			rules.NewStatusEnumDocumentsTerminal(nil),
+			rules.NewInterfaceFieldsHaveDescriptions(),
+			rules.NewNoUnusedResponseUnions(),
+			rules.NewUnionMinMembers(),
+			rules.NewNoQueryMutationNameCollision(nil),
+			rules.NewUnionMembersShareInterface(),
+			rules.NewMutableTypesHaveMutations(nil),
+			rules.NewNoFloatMoney(nil, ""),
+			rules.NewScalarListPluralization(nil),
+			rules.NewConsistentTimestampScalar(""),
+			rules.NewDirectiveSelectionTypeValid(),
+			rules.NewLimitBooleanArguments(0),
+			rules.NewNoRedundantFieldTypeName(),
+			rules.NewPublicTypesDocumented(false),
+			rules.NewPageInfoCursorConsistency(),
+			rules.NewConsistentFieldCasePerType(),
+			rules.NewEnumArgumentDefaultOrNonNull(),
+			rules.NewHoistCommonFieldsToInterface(),
+			rules.NewRelayNodeField(),
+			rules.NewNoAbbreviations(nil),
+			rules.NewArgumentsHaveDescriptions(false),
+			rules.NewFederationRequiresFieldsExist(),
+			rules.NewFederationProvidesFieldsExist(),
+			rules.NewFederationExternalUnused(),
+			rules.NewKeyShareableConflict(),
+			rules.NewNoConflictingTypeDefinitions(),
+			rules.NewKeyFieldsNonNull(),
+			rules.NewListFieldsPlural(nil),
+			rules.NewSingleFieldsSingular(nil, nil),
+			rules.NewNoDeprecatedRequiredField(),
+			rules.NewMutationSingleInput(),
+			rules.NewUnionNoScalarMembers(),
+			rules.NewReservedPaginationArgNames(),
+			rules.NewSuggestOneOfInput(),
+			rules.NewOneOfFieldsNullable(),
+			rules.NewRootFieldsHaveDescriptions(),
+			rules.NewNoSimilarTypeNames(),
+			rules.NewBooleanFieldPrefix(nil),
+			rules.NewInterfaceFieldCompatibility(),
+			rules.NewRequireQueryType(),
+			rules.NewNoEmptyDefinitions(),
+			rules.NewMaxEnumValues(0),
+			rules.NewNoListOfLists(),
+			rules.NewKeyNoDeprecatedFields(),
+			rules.NewConsistentFieldNullability(),
+			rules.NewCursorFieldNaming(),
+			rules.NewIdArgumentSemantics(),
+			rules.NewErrorTypeShape(),
+			rules.NewErrorTypeFieldUsage(),
+			rules.NewNonNullList(),
+			rules.NewMutationPayloadWrapper(),
+			rules.NewConnectionEdgesNonNull(),
+			rules.NewArgumentDefaultValid(),
+			rules.NewDescriptionNoMarkdownHeaders(),
+			rules.NewNoCaseCollidingArguments(),
+			rules.NewInputOutputFieldParity(nil, nil),
+			rules.NewSubscriptionFieldCount(0, true),
+			rules.NewConsistentCollectionExposure(),
+			rules.NewTagNaming(nil, nil),
+			rules.NewPreferEnumOverString(nil),
+			rules.NewMutationPayloadErrors(nil),
+			rules.NewSingleIdentifierPerType(),
+			rules.NewDescriptionWhitespace(),
+			rules.NewUnionMemberNaming(""),
+			rules.NewDeprecationPastRemoval(),
+			rules.NewInterfaceNamingStyle(nil),
+			rules.NewConsistentAcronymCasing(rules.AcronymTitleCase, nil),
+			rules.NewAtLeastOneNonNullField(),
+			rules.NewNoUnreferencedInterface(),
+			rules.NewEnumDescriptionNoInternal(nil),
+			rules.NewNoRedundantParentIdArg(),
+			rules.NewEnumFieldNameRelation(),
+			rules.NewConnectionNodeHasId(),
+			rules.NewEntityIdNonNull(),
+			rules.NewNoRequiredRecursiveOutput(),
 		},
 		enabledRules: make(map[string]bool),
+		jobs:         runtime.NumCPU(),
 	}
 }
 
@@ -87,6 +198,10 @@ func (l *Linter) LoadCustomRules(customRulesDir string) error {
 	return nil
 }
 
+// PluginAPIVersion is the version of the plugin API supported by this linter.
+// Plugins may export a PluginAPIVersion int variable to declare the version they target.
+const PluginAPIVersion = 1
+
 // loadPlugin loads a single Go plugin file
 func (l *Linter) loadPlugin(pluginPath string) error {
 	// Load the plugin
@@ -95,21 +210,57 @@ func (l *Linter) loadPlugin(pluginPath string) error {
 		return fmt.Errorf("failed to open plugin: %w", err)
 	}
 
+	return l.registerPluginRules(pluginPath, p.Lookup)
+}
+
+// registerPluginRules checks the plugin API version and registers the rules exported by a plugin.
+// A plugin exports a NewRule function, a Rules slice, or both.
+func (l *Linter) registerPluginRules(pluginPath string, lookup func(string) (plugin.Symbol, error)) error {
+	// Check the API version handshake, if the plugin declares one
+	if versionSymbol, err := lookup("PluginAPIVersion"); err == nil {
+		version, ok := versionSymbol.(*int)
+		if !ok {
+			return fmt.Errorf("PluginAPIVersion must be an int variable")
+		}
+		if *version != PluginAPIVersion {
+			return fmt.Errorf("plugin %s targets API version %d but this linter supports version %d", pluginPath, *version, PluginAPIVersion)
+		}
+	}
+
+	var pluginRules []types.Rule
+
+	// Look for the Rules slice
+	rulesSymbol, rulesErr := lookup("Rules")
+	if rulesErr == nil {
+		exportedRules, ok := rulesSymbol.(*[]types.Rule)
+		if !ok {
+			return fmt.Errorf("Rules must be a variable of type []types.Rule")
+		}
+		pluginRules = append(pluginRules, *exportedRules...)
+	}
+
 	// Look for the NewRule function
-	newRuleSymbol, err := p.Lookup("NewRule")
-	if err != nil {
-		return fmt.Errorf("plugin must export NewRule function: %w", err)
+	newRuleSymbol, newRuleErr := lookup("NewRule")
+	if newRuleErr == nil {
+		// Cast to expected function signature
+		newRuleFunc, ok := newRuleSymbol.(func() types.Rule)
+		if !ok {
+			return fmt.Errorf("NewRule must be a function that returns types.Rule")
+		}
+		pluginRules = append(pluginRules, newRuleFunc())
 	}
 
-	// Cast to expected function signature
-	newRuleFunc, ok := newRuleSymbol.(func() types.Rule)
-	if !ok {
-		return fmt.Errorf("NewRule must be a function that returns types.Rule")
+	if rulesErr != nil && newRuleErr != nil {
+		return fmt.Errorf("plugin must export NewRule function or Rules slice: %w", newRuleErr)
 	}
 
-	// Create the rule and add it to our rules list
-	rule := newRuleFunc()
-	l.rules = append(l.rules, rule)
+	// Add the rules to our rules list
+	for _, rule := range pluginRules {
+		if rule == nil {
+			return fmt.Errorf("plugin %s exported a nil rule", pluginPath)
+		}
+		l.rules = append(l.rules, rule)
+	}
 
 	return nil
 }
@@ -122,50 +273,353 @@ func (l *Linter) SetRules(ruleNames []string) {
 	}
 }
 
+// SetJobs sets the maximum number of rules that are checked concurrently.
+// Values below 1 fall back to the number of CPUs.
+func (l *Linter) SetJobs(jobs int) {
+	if jobs < 1 {
+		jobs = runtime.NumCPU()
+	}
+	l.jobs = jobs
+}
+
+// SetNow sets the date that date-dependent rules compare against, e.g. to reproduce a past run.
+// The zero time means the system date.
+func (l *Linter) SetNow(now time.Time) {
+	l.now = now
+	for _, rule := range l.rules {
+		if dated, ok := rule.(types.DatedRule); ok {
+			dated.SetNow(now)
+		}
+	}
+}
+
+// SetProfiling enables or disables measuring the time spent in each rule.
+// Enabling profiling discards previously collected profiles.
+func (l *Linter) SetProfiling(enabled bool) {
+	l.profileMu.Lock()
+	defer l.profileMu.Unlock()
+	l.profiling = enabled
+	l.profiles = make(map[string]*RuleProfile)
+}
+
+// Profile returns the collected rule profiles, slowest rule first
+func (l *Linter) Profile() []RuleProfile {
+	l.profileMu.Lock()
+	defer l.profileMu.Unlock()
+
+	profiles := make([]RuleProfile, 0, len(l.profiles))
+	for _, profile := range l.profiles {
+		profiles = append(profiles, *profile)
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		if profiles[i].Duration != profiles[j].Duration {
+			return profiles[i].Duration > profiles[j].Duration
+		}
+		return profiles[i].Rule < profiles[j].Rule
+	})
+	return profiles
+}
+
+// checkRule runs a rule's check, recording its duration and error count when profiling
+func (l *Linter) checkRule(rule types.Rule, check func() []types.LintError) []types.LintError {
+	if !l.profiling {
+		return check()
+	}
+
+	start := time.Now()
+	errors := check()
+	elapsed := time.Since(start)
+
+	l.profileMu.Lock()
+	defer l.profileMu.Unlock()
+	profile, ok := l.profiles[rule.Name()]
+	if !ok {
+		profile = &RuleProfile{Rule: rule.Name()}
+		l.profiles[rule.Name()] = profile
+	}
+	profile.Duration += elapsed
+	profile.Errors += len(errors)
+
+	return errors
+}
+
 // LintFile lints a single GraphQL schema file
 func (l *Linter) LintFile(filename string) ([]types.LintError, error) {
-	// Read and parse the schema
-	schema, source, err := l.parseSchemaFile(filename)
+	source, err := readSource(filename)
 	if err != nil {
 		return nil, err
 	}
 
-	// Run all enabled rules
+	return l.LintSource(source)
+}
+
+// LintSource lints GraphQL schema text that is not read from a file, such as standard input.
+// With a cache directory set, cached results are returned without parsing the source.
+func (l *Linter) LintSource(source *ast.Source) ([]types.LintError, error) {
+	var key string
+	if l.cacheDir != "" {
+		key = l.cacheKey(source)
+		if errors, ok := l.loadCached(key); ok {
+			return errors, nil
+		}
+	}
+
+	schema, err := l.parseSource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	errors := l.runRules(schema, source)
+	if l.cacheDir != "" {
+		l.storeCached(key, errors)
+	}
+
+	return errors, nil
+}
+
+// LintFiles lints schema files that together make up one schema
+func (l *Linter) LintFiles(filenames []string) ([]types.LintError, error) {
+	var sources []*ast.Source
+	for _, filename := range filenames {
+		source, err := readSource(filename)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+
+	return l.LintSources(sources)
+}
+
+// LintSources lints sources that together make up one schema. Each source is
+// linted on its own, then enabled MultiFileRules check definitions across all of them.
+// Only the per-source results are cached; MultiFileRules always run.
+//
+// A source that only parses together with the others, such as one that refers to
+// a type declared in another file, is checked by MultiFileRules alone: per-source
+// rules report every location against the source they are given, so they can't
+// be run on the combined schema. If the sources don't parse together either, the
+// source's own parse error is returned.
+func (l *Linter) LintSources(sources []*ast.Source) ([]types.LintError, error) {
 	var errors []types.LintError
+	var combinedErr error
+	combinedLoaded := false
+	for _, source := range sources {
+		sourceErrors, err := l.LintSource(source)
+		if err != nil {
+			if !combinedLoaded {
+				_, combinedErr = l.parseSources(sources)
+				combinedLoaded = true
+			}
+			if combinedErr != nil {
+				return nil, err
+			}
+			continue
+		}
+		errors = append(errors, sourceErrors...)
+	}
+
+	for _, rule := range l.activeRules() {
+		if multiFile, ok := rule.(types.MultiFileRule); ok {
+			errors = append(errors, l.checkRule(rule, func() []types.LintError {
+				return multiFile.CheckFiles(sources)
+			})...)
+		}
+	}
+	SortErrors(errors)
+
+	return errors, nil
+}
+
+// FixFile applies the fixes of enabled Fixable rules that report problems in a file
+// and writes the result back. It reports whether the file was changed.
+func (l *Linter) FixFile(filename string) (bool, error) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat file %s: %w", filename, err)
+	}
+
+	schema, source, err := l.parseSchemaFile(filename)
+	if err != nil {
+		return false, err
+	}
+	original := source.Input
+
+	for _, rule := range l.activeRules() {
+		fixable, ok := rule.(types.Fixable)
+		if !ok {
+			continue
+		}
+
+		// Only fix files where the rule actually fired
+		if len(rule.Check(schema, source)) == 0 {
+			continue
+		}
+
+		fixed, err := fixable.Fix(source)
+		if err != nil {
+			return false, fmt.Errorf("rule %s failed to fix %s: %w", rule.Name(), filename, err)
+		}
+		if fixed == source.Input {
+			continue
+		}
+
+		// Re-parse so the next rule checks the fixed contents
+		source = &ast.Source{Name: filename, Input: fixed}
+		schema, err = gqlparser.LoadSchema(source)
+		if err != nil {
+			return false, fmt.Errorf("rule %s produced an invalid schema for %s: %w", rule.Name(), filename, err)
+		}
+	}
+
+	if source.Input == original {
+		return false, nil
+	}
+
+	if err := os.WriteFile(filename, []byte(source.Input), info.Mode().Perm()); err != nil {
+		return false, fmt.Errorf("failed to write file %s: %w", filename, err)
+	}
+	return true, nil
+}
+
+// activeRules returns the rules to run, honoring SetRules and opt-in rules
+func (l *Linter) activeRules() []types.Rule {
+	var active []types.Rule
 	for _, rule := range l.rules {
 		// Skip rule if specific rules are set and this rule is not enabled
 		if len(l.enabledRules) > 0 && !l.enabledRules[rule.Name()] {
 			continue
 		}
+		// Opt-in rules only run when explicitly enabled
+		if len(l.enabledRules) == 0 && isOptIn(rule) {
+			continue
+		}
+		active = append(active, rule)
+	}
+	return active
+}
+
+// runRules checks all enabled rules against the schema using a bounded pool of workers
+func (l *Linter) runRules(schema *ast.Schema, source *ast.Source) []types.LintError {
+	enabled := l.activeRules()
 
-		ruleErrors := rule.Check(schema, source)
+	workers := l.jobs
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(enabled) {
+		workers = len(enabled)
+	}
+
+	ruleCh := make(chan types.Rule)
+	resultCh := make(chan []types.LintError, len(enabled))
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for rule := range ruleCh {
+				resultCh <- l.checkRule(rule, func() []types.LintError {
+					return rule.Check(schema, source)
+				})
+			}
+		}()
+	}
+
+	for _, rule := range enabled {
+		ruleCh <- rule
+	}
+	close(ruleCh)
+	wg.Wait()
+	close(resultCh)
+
+	var errors []types.LintError
+	for ruleErrors := range resultCh {
 		errors = append(errors, ruleErrors...)
 	}
 
-	return errors, nil
+	// Results arrive in completion order, and most rules iterate schema maps,
+	// so order them by location to keep the output stable between runs
+	SortErrors(errors)
+
+	return errors
+}
+
+// isOptIn reports whether a rule must be explicitly enabled to run
+func isOptIn(rule types.Rule) bool {
+	optIn, ok := rule.(types.OptInRule)
+	return ok && optIn.OptIn()
+}
+
+// SortErrors orders errors by file, line, column, rule and message so that
+// output is stable regardless of the order in which rules and files were linted
+func SortErrors(errors []types.LintError) {
+	sort.SliceStable(errors, func(i, j int) bool {
+		a, b := errors[i], errors[j]
+		if a.Location.File != b.Location.File {
+			return a.Location.File < b.Location.File
+		}
+		if a.Location.Line != b.Location.Line {
+			return a.Location.Line < b.Location.Line
+		}
+		if a.Location.Column != b.Location.Column {
+			return a.Location.Column < b.Location.Column
+		}
+		if a.Rule != b.Rule {
+			return a.Rule < b.Rule
+		}
+		return a.Message < b.Message
+	})
 }
 
 // parseSchemaFile reads and parses a GraphQL schema file
 func (l *Linter) parseSchemaFile(filename string) (*ast.Schema, *ast.Source, error) {
+	source, err := readSource(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schema, err := l.parseSource(source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return schema, source, nil
+}
+
+// readSource reads a GraphQL schema file into a source named after the file
+func readSource(filename string) (*ast.Source, error) {
 	// Read file content
 	content, err := os.ReadFile(filename)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to read file %s: %w", filename, err)
+		return nil, fmt.Errorf("failed to read file %s: %w", filename, err)
 	}
 
-	// Create source from file
-	source := &ast.Source{
+	return &ast.Source{
 		Name:  filename,
 		Input: string(content),
-	}
+	}, nil
+}
 
-	// Parse the schema
+// parseSource parses a GraphQL schema source
+func (l *Linter) parseSource(source *ast.Source) (*ast.Schema, error) {
 	schema, err := gqlparser.LoadSchema(source)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to parse schema: %w", err)
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
 	}
 
-	return schema, source, nil
+	return schema, nil
+}
+
+// parseSources parses sources together as one GraphQL schema
+func (l *Linter) parseSources(sources []*ast.Source) (*ast.Schema, error) {
+	schema, err := gqlparser.LoadSchema(sources...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	return schema, nil
 }
 
 // GetAvailableRules returns all available rule names
@@ -176,3 +630,28 @@ func (l *Linter) GetAvailableRules() []string {
 	}
 	return ruleNames
 }
+
+// GetActiveRules returns the names of the rules that will run, honoring SetRules and opt-in rules
+func (l *Linter) GetActiveRules() []string {
+	var ruleNames []string
+	for _, rule := range l.activeRules() {
+		ruleNames = append(ruleNames, rule.Name())
+	}
+	return ruleNames
+}
+
+// GetRuleInfos returns the name and description of every available rule, sorted by name
+func (l *Linter) GetRuleInfos() []RuleInfo {
+	infos := make([]RuleInfo, 0, len(l.rules))
+	for _, rule := range l.rules {
+		infos = append(infos, RuleInfo{
+			Name:        rule.Name(),
+			Description: rule.Description(),
+			OptIn:       isOptIn(rule),
+		})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos
+}
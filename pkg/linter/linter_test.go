@@ -1,10 +1,20 @@
 package linter
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
 	"os"
 	"path/filepath"
+	"plugin"
+	"reflect"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
 )
 
 // Test schema content for various test scenarios
@@ -36,6 +46,22 @@ const (
 		}
 	`
 
+	optInSchema = `
+		directive @key(fields: String!) on OBJECT
+
+		interface FeedItem {
+			id: ID!
+		}
+
+		type Post implements FeedItem @key(fields: "id") {
+			id: ID!
+		}
+
+		type Query {
+			item: FeedItem
+		}
+	`
+
 	malformedSchema = `
 		type User {
 			id: ID!
@@ -59,7 +85,7 @@ func TestNew(t *testing.T) {
 	}
 
 	// Check that all expected rules are loaded
-	expectedRuleCount := 36 // Based on the rules in the New() function
+	expectedRuleCount := 117 // Based on the rules in the New() function
 	if len(linter.rules) != expectedRuleCount {
 		t.Errorf("Expected %d rules, got %d", expectedRuleCount, len(linter.rules))
 	}
@@ -99,6 +125,46 @@ func TestGetAvailableRules(t *testing.T) {
 	}
 }
 
+func TestGetRuleInfos(t *testing.T) {
+	linter := New()
+	infos := linter.GetRuleInfos()
+
+	if len(infos) != len(linter.GetAvailableRules()) {
+		t.Fatalf("Expected %d rule infos, got %d", len(linter.GetAvailableRules()), len(infos))
+	}
+	for i, info := range infos {
+		if info.Description == "" {
+			t.Errorf("Expected rule %s to have a description", info.Name)
+		}
+		if i > 0 && infos[i-1].Name >= info.Name {
+			t.Errorf("Expected rule infos sorted by name, got %s before %s", infos[i-1].Name, info.Name)
+		}
+		if info.Name == "consistent-field-nullability" && !info.OptIn {
+			t.Errorf("Expected rule %s to be reported as opt-in", info.Name)
+		}
+	}
+}
+
+func TestGetActiveRules(t *testing.T) {
+	linter := New()
+
+	active := linter.GetActiveRules()
+	if len(active) == 0 || len(active) >= len(linter.GetAvailableRules()) {
+		t.Errorf("Expected default rules without opt-in rules, got %d of %d", len(active), len(linter.GetAvailableRules()))
+	}
+	for _, name := range active {
+		if name == "consistent-field-nullability" {
+			t.Errorf("Expected opt-in rule %s not to be active by default", name)
+		}
+	}
+
+	linter.SetRules([]string{"consistent-field-nullability", "alphabetize"})
+	active = linter.GetActiveRules()
+	if len(active) != 2 {
+		t.Errorf("Expected exactly the enabled rules to be active, got %v", active)
+	}
+}
+
 func TestSetRules(t *testing.T) {
 	linter := New()
 
@@ -125,6 +191,89 @@ func TestSetRules(t *testing.T) {
 	}
 }
 
+func TestSetJobs(t *testing.T) {
+	linter := New()
+
+	if linter.jobs != runtime.NumCPU() {
+		t.Errorf("Expected jobs to default to %d, got %d", runtime.NumCPU(), linter.jobs)
+	}
+
+	linter.SetJobs(3)
+	if linter.jobs != 3 {
+		t.Errorf("Expected jobs to be 3, got %d", linter.jobs)
+	}
+
+	// Non-positive values fall back to the CPU count
+	linter.SetJobs(0)
+	if linter.jobs != runtime.NumCPU() {
+		t.Errorf("Expected jobs to fall back to %d, got %d", runtime.NumCPU(), linter.jobs)
+	}
+}
+
+func TestProfiling(t *testing.T) {
+	t.Run("should record every rule that ran", func(t *testing.T) {
+		linter := New()
+		linter.SetProfiling(true)
+
+		errors, err := linter.LintSource(&ast.Source{Name: "schema.graphql", Input: invalidSchema})
+		if err != nil {
+			t.Fatalf("Expected no error linting source, got: %v", err)
+		}
+
+		profiles := linter.Profile()
+		if len(profiles) != len(linter.activeRules()) {
+			t.Errorf("Expected a profile for each of the %d active rules, got %d", len(linter.activeRules()), len(profiles))
+		}
+
+		total := 0
+		for i, profile := range profiles {
+			total += profile.Errors
+			if i > 0 && profile.Duration > profiles[i-1].Duration {
+				t.Errorf("Expected profiles sorted slowest first, but %s is slower than %s", profile.Rule, profiles[i-1].Rule)
+			}
+		}
+		if total != len(errors) {
+			t.Errorf("Expected profiled error counts to add up to %d, got %d", len(errors), total)
+		}
+	})
+
+	t.Run("should not record anything when disabled", func(t *testing.T) {
+		linter := New()
+
+		if _, err := linter.LintSource(&ast.Source{Name: "schema.graphql", Input: invalidSchema}); err != nil {
+			t.Fatalf("Expected no error linting source, got: %v", err)
+		}
+		if profiles := linter.Profile(); len(profiles) != 0 {
+			t.Errorf("Expected no profiles, got %d", len(profiles))
+		}
+	})
+}
+
+func TestSortErrors(t *testing.T) {
+	expected := []types.LintError{
+		{Message: "a", Rule: "rule-a", Location: types.Location{File: "a.graphql", Line: 1, Column: 1}},
+		{Message: "b", Rule: "rule-a", Location: types.Location{File: "a.graphql", Line: 1, Column: 1}},
+		{Message: "a", Rule: "rule-b", Location: types.Location{File: "a.graphql", Line: 1, Column: 1}},
+		{Message: "a", Rule: "rule-a", Location: types.Location{File: "a.graphql", Line: 1, Column: 5}},
+		{Message: "a", Rule: "rule-a", Location: types.Location{File: "a.graphql", Line: 2, Column: 1}},
+		{Message: "a", Rule: "rule-a", Location: types.Location{File: "b.graphql", Line: 1, Column: 1}},
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		shuffled := make([]types.LintError, len(expected))
+		copy(shuffled, expected)
+		rng.Shuffle(len(shuffled), func(a, b int) {
+			shuffled[a], shuffled[b] = shuffled[b], shuffled[a]
+		})
+
+		SortErrors(shuffled)
+		if !reflect.DeepEqual(shuffled, expected) {
+			t.Fatalf("Expected deterministic order %v, got %v", expected, shuffled)
+		}
+	}
+}
+
 func TestParseSchemaFile(t *testing.T) {
 	linter := New()
 
@@ -268,6 +417,89 @@ func TestLintFile(t *testing.T) {
 		}
 	})
 
+	t.Run("should produce the same errors regardless of job count", func(t *testing.T) {
+		tmpFile, err := createTempSchemaFile(t, invalidSchema)
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(tmpFile) }()
+
+		sequential := New()
+		sequential.SetJobs(1)
+		expected, err := sequential.LintFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error linting file, got: %v", err)
+		}
+
+		parallel := New()
+		parallel.SetJobs(8)
+		actual, err := parallel.LintFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error linting file, got: %v", err)
+		}
+
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected parallel run to match sequential run: got %d errors, want %d", len(actual), len(expected))
+		}
+	})
+
+	t.Run("should return errors in the same order on every run", func(t *testing.T) {
+		tmpFile, err := createTempSchemaFile(t, invalidSchema)
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(tmpFile) }()
+
+		l := New()
+		first, err := l.LintFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error linting file, got: %v", err)
+		}
+		second, err := l.LintFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error linting file, got: %v", err)
+		}
+
+		if !reflect.DeepEqual(first, second) {
+			t.Error("Expected identical errors when linting the same schema twice")
+		}
+
+		for i := 1; i < len(first); i++ {
+			prev, cur := first[i-1].Location, first[i].Location
+			if prev.Line > cur.Line || (prev.Line == cur.Line && prev.Column > cur.Column) {
+				t.Errorf("Expected errors sorted by location, got %d:%d before %d:%d", prev.Line, prev.Column, cur.Line, cur.Column)
+			}
+		}
+	})
+
+	t.Run("should only run opt-in rules when explicitly enabled", func(t *testing.T) {
+		tmpFile, err := createTempSchemaFile(t, optInSchema)
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(tmpFile) }()
+
+		l := New()
+		errors, err := l.LintFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error linting file, got: %v", err)
+		}
+		for _, e := range errors {
+			if e.Rule == "abstract-entity-fields-node" {
+				t.Fatal("Expected opt-in rule to be skipped by default")
+			}
+		}
+
+		l.SetRules([]string{"abstract-entity-fields-node"})
+		errors, err = l.LintFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error linting file, got: %v", err)
+		}
+		if len(errors) == 0 {
+			t.Error("Expected opt-in rule to run when explicitly enabled")
+		}
+	})
+
 	t.Run("should fail on non-existent file", func(t *testing.T) {
 		_, err := linter.LintFile("non-existent-file.graphql")
 		if err == nil {
@@ -289,6 +521,445 @@ func TestLintFile(t *testing.T) {
 	})
 }
 
+func TestLintSource(t *testing.T) {
+	t.Run("should lint schema text that is not read from a file", func(t *testing.T) {
+		linter := New()
+
+		errors, err := linter.LintSource(&ast.Source{Name: "<stdin>", Input: invalidSchema})
+		if err != nil {
+			t.Fatalf("Expected no error linting source, got: %v", err)
+		}
+		if len(errors) == 0 {
+			t.Fatal("Expected errors for invalid schema")
+		}
+		for _, lintErr := range errors {
+			if lintErr.Location.File != "<stdin>" {
+				t.Errorf("Expected errors to be reported in <stdin>, got %s", lintErr.Location.File)
+			}
+		}
+	})
+
+	t.Run("should match linting the same schema from a file", func(t *testing.T) {
+		linter := New()
+
+		tmpFile, err := createTempSchemaFile(t, invalidSchema)
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(tmpFile) }()
+
+		fileErrors, err := linter.LintFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error linting file, got: %v", err)
+		}
+		sourceErrors, err := linter.LintSource(&ast.Source{Name: tmpFile, Input: invalidSchema})
+		if err != nil {
+			t.Fatalf("Expected no error linting source, got: %v", err)
+		}
+		if !reflect.DeepEqual(fileErrors, sourceErrors) {
+			t.Error("Expected LintSource to match LintFile")
+		}
+	})
+
+	t.Run("should fail on malformed schema text", func(t *testing.T) {
+		linter := New()
+
+		if _, err := linter.LintSource(&ast.Source{Name: "<stdin>", Input: malformedSchema}); err == nil {
+			t.Error("Expected error for malformed schema")
+		}
+	})
+
+	// The parser rejects output types in argument positions, so no rule needs to check for them
+	t.Run("should fail on output types used as arguments", func(t *testing.T) {
+		linter := New()
+
+		schemas := map[string]string{
+			"field argument": `
+			type User { id: ID! }
+			type Query { user: User }
+			type Mutation { save(user: User): User }`,
+			"directive argument": `
+			type User { id: ID! }
+			type Query { user: User @audit }
+			directive @audit(by: [User!]) on FIELD_DEFINITION`,
+		}
+		for position, schema := range schemas {
+			_, err := linter.LintSource(&ast.Source{Name: "<stdin>", Input: schema})
+			if err == nil || !strings.Contains(err.Error(), "OBJECT is not a valid input type") {
+				t.Errorf("Expected %s with an output type to be rejected, got: %v", position, err)
+			}
+		}
+	})
+
+	// The parser checks applied directives against their declared locations, so no rule needs to check them
+	t.Run("should fail on directives applied outside their locations", func(t *testing.T) {
+		linter := New()
+
+		schemas := map[string]string{
+			"FIELD_DEFINITION": `
+			directive @internal on OBJECT
+			type Query { user: String @internal }`,
+			"OBJECT": `
+			directive @internal on FIELD_DEFINITION
+			type Query @internal { user: String }`,
+			"ARGUMENT_DEFINITION": `
+			directive @internal on OBJECT
+			type Query { user(id: ID @internal): String }`,
+			"ENUM_VALUE": `
+			directive @internal on OBJECT
+			enum Status { ACTIVE @internal }
+			type Query { status: Status }`,
+		}
+		for location, schema := range schemas {
+			_, err := linter.LintSource(&ast.Source{Name: "<stdin>", Input: schema})
+			if err == nil || !strings.Contains(err.Error(), "is not applicable on "+location) {
+				t.Errorf("Expected directive on %s to be rejected, got: %v", location, err)
+			}
+		}
+	})
+}
+
+func TestLintFiles(t *testing.T) {
+	t.Run("should run multi-file rules across files", func(t *testing.T) {
+		linter := New()
+		linter.SetRules([]string{"no-conflicting-type-definitions"})
+
+		objectFile, err := createTempSchemaFile(t, "type User { id: ID! }\ntype Query { user: User }")
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(objectFile) }()
+
+		interfaceFile, err := createTempSchemaFile(t, "interface User { id: ID! }\ntype Query { user: User }")
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(interfaceFile) }()
+
+		errors, err := linter.LintFiles([]string{objectFile, interfaceFile})
+		if err != nil {
+			t.Fatalf("Expected no error linting files, got: %v", err)
+		}
+		if len(errors) != 1 {
+			t.Fatalf("Expected 1 cross-file error, got %d", len(errors))
+		}
+		if errors[0].Location.File != interfaceFile {
+			t.Errorf("Expected error in %s, got %s", interfaceFile, errors[0].Location.File)
+		}
+	})
+
+	t.Run("should include per-file errors", func(t *testing.T) {
+		linter := New()
+
+		tmpFile, err := createTempSchemaFile(t, invalidSchema)
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(tmpFile) }()
+
+		fileErrors, err := linter.LintFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error linting file, got: %v", err)
+		}
+		errors, err := linter.LintFiles([]string{tmpFile})
+		if err != nil {
+			t.Fatalf("Expected no error linting files, got: %v", err)
+		}
+		if !reflect.DeepEqual(errors, fileErrors) {
+			t.Errorf("Expected LintFiles to match LintFile for a single file")
+		}
+	})
+
+	t.Run("should lint files that only parse together", func(t *testing.T) {
+		linter := New()
+		linter.SetRules([]string{"no-conflicting-type-definitions", "types-have-descriptions"})
+
+		baseFile, err := createTempSchemaFile(t, "type User { id: ID! }\ntype Query { user: User }")
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(baseFile) }()
+
+		postFile, err := createTempSchemaFile(t, "type Post { author: User }\nextend type Query { posts: [Post!]! }")
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(postFile) }()
+
+		if _, err := linter.LintFile(postFile); err == nil {
+			t.Fatal("Expected the post file not to parse on its own")
+		}
+
+		errors, err := linter.LintFiles([]string{baseFile, postFile})
+		if err != nil {
+			t.Fatalf("Expected no error linting files, got: %v", err)
+		}
+
+		// Per-file rules still run on the base file, but not on the file that needs it
+		var baseErrors int
+		for _, lintErr := range errors {
+			switch lintErr.Location.File {
+			case baseFile:
+				baseErrors++
+			case postFile:
+				t.Errorf("Expected no per-file errors in %s, got: %v", postFile, lintErr)
+			}
+		}
+		if baseErrors == 0 {
+			t.Errorf("Expected per-file errors in %s", baseFile)
+		}
+	})
+
+	t.Run("should fail when files don't parse together", func(t *testing.T) {
+		linter := New()
+
+		postFile, err := createTempSchemaFile(t, "type Post { author: User }\ntype Query { posts: [Post!]! }")
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(postFile) }()
+
+		if _, err := linter.LintFiles([]string{postFile}); err == nil {
+			t.Error("Expected error for an undefined type")
+		}
+	})
+
+	t.Run("should check the Query root type across files", func(t *testing.T) {
+		linter := New()
+		linter.SetRules([]string{"require-query-type"})
+
+		queryFile, err := createTempSchemaFile(t, "type Query { version: String }")
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(queryFile) }()
+
+		userFile, err := createTempSchemaFile(t, "type User { id: ID! }")
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(userFile) }()
+
+		errors, err := linter.LintFiles([]string{queryFile, userFile})
+		if err != nil {
+			t.Fatalf("Expected no error linting files, got: %v", err)
+		}
+		if len(errors) > 0 {
+			t.Errorf("Expected no errors when another file defines Query, got %v", errors)
+		}
+
+		errors, err = linter.LintFiles([]string{userFile})
+		if err != nil {
+			t.Fatalf("Expected no error linting files, got: %v", err)
+		}
+		if len(errors) != 1 || errors[0].Rule != "require-query-type" {
+			t.Errorf("Expected a missing Query error, got %v", errors)
+		}
+	})
+
+	t.Run("should fail on unparsable files", func(t *testing.T) {
+		linter := New()
+
+		tmpFile, err := createTempSchemaFile(t, malformedSchema)
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(tmpFile) }()
+
+		if _, err := linter.LintFiles([]string{tmpFile}); err == nil {
+			t.Error("Expected error for malformed schema")
+		}
+	})
+}
+
+func TestCache(t *testing.T) {
+	source := &ast.Source{Name: "schema.graphql", Input: invalidSchema}
+
+	t.Run("should return the same results from the cache", func(t *testing.T) {
+		linter := New()
+		linter.SetCacheDir(t.TempDir())
+
+		first, err := linter.LintSource(source)
+		if err != nil {
+			t.Fatalf("Expected no error linting source, got: %v", err)
+		}
+		second, err := linter.LintSource(source)
+		if err != nil {
+			t.Fatalf("Expected no error linting cached source, got: %v", err)
+		}
+		if !reflect.DeepEqual(first, second) {
+			t.Error("Expected cached results to match the original results")
+		}
+	})
+
+	t.Run("should skip parsing and rules on a cache hit", func(t *testing.T) {
+		cacheDir := t.TempDir()
+		linter := New()
+		linter.SetCacheDir(cacheDir)
+
+		if _, err := linter.LintSource(source); err != nil {
+			t.Fatalf("Expected no error linting source, got: %v", err)
+		}
+
+		// Replace the entry so a hit is distinguishable from a fresh lint
+		cached := []types.LintError{{Message: "cached", Rule: "cache"}}
+		data, _ := json.Marshal(cached)
+		if err := os.WriteFile(filepath.Join(cacheDir, linter.cacheKey(source)+".json"), data, 0644); err != nil {
+			t.Fatalf("Failed to write cache entry: %v", err)
+		}
+
+		errors, err := linter.LintSource(source)
+		if err != nil {
+			t.Fatalf("Expected no error linting cached source, got: %v", err)
+		}
+		if !reflect.DeepEqual(errors, cached) {
+			t.Errorf("Expected cached errors, got %v", errors)
+		}
+	})
+
+	t.Run("should miss when the content or rules change", func(t *testing.T) {
+		linter := New()
+		key := linter.cacheKey(source)
+
+		if linter.cacheKey(&ast.Source{Name: source.Name, Input: validSchema}) == key {
+			t.Error("Expected different contents to produce a different key")
+		}
+		if linter.cacheKey(&ast.Source{Name: "other.graphql", Input: source.Input}) == key {
+			t.Error("Expected different file names to produce a different key")
+		}
+
+		linter.SetRules([]string{"types-have-descriptions"})
+		if linter.cacheKey(source) == key {
+			t.Error("Expected a different rule set to produce a different key")
+		}
+	})
+
+	t.Run("should miss on another day when a dated rule is active", func(t *testing.T) {
+		linter := New()
+		linter.SetNow(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+		key := linter.cacheKey(source)
+
+		linter.SetNow(time.Date(2024, time.June, 2, 0, 0, 0, 0, time.UTC))
+		if linter.cacheKey(source) != key {
+			t.Error("Expected the date not to affect the key without dated rules")
+		}
+
+		linter.SetRules([]string{"deprecation-past-removal"})
+		key = linter.cacheKey(source)
+		linter.SetNow(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+		if linter.cacheKey(source) == key {
+			t.Error("Expected a different date to produce a different key")
+		}
+	})
+}
+
+func TestSetNow(t *testing.T) {
+	source := &ast.Source{Name: "schema.graphql", Input: `
+		type Query {
+			legacyId: ID @deprecated(reason: "Deprecated 2023-01-01; use id")
+		}
+	`}
+
+	linter := New()
+	linter.SetRules([]string{"deprecation-past-removal"})
+
+	linter.SetNow(time.Date(2022, time.December, 31, 0, 0, 0, 0, time.UTC))
+	errors, err := linter.LintSource(source)
+	if err != nil {
+		t.Fatalf("Expected no error linting source, got: %v", err)
+	}
+	if len(errors) != 0 {
+		t.Errorf("Expected no errors before the removal date, got %v", errors)
+	}
+
+	linter.SetNow(time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC))
+	errors, err = linter.LintSource(source)
+	if err != nil {
+		t.Fatalf("Expected no error linting source, got: %v", err)
+	}
+	if len(errors) != 1 {
+		t.Errorf("Expected 1 error after the removal date, got %v", errors)
+	}
+}
+
+func TestFixFile(t *testing.T) {
+	unordered := "type Query {\n  user: User\n}\n\ntype User {\n  name: String\n  id: ID!\n}\n"
+	ordered := "type Query {\n  user: User\n}\n\ntype User {\n  id: ID!\n  name: String\n}\n"
+
+	t.Run("should fix files where a fixable rule fired", func(t *testing.T) {
+		linter := New()
+		linter.SetRules([]string{"alphabetize"})
+
+		tmpFile, err := createTempSchemaFile(t, unordered)
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(tmpFile) }()
+
+		changed, err := linter.FixFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error fixing file, got: %v", err)
+		}
+		if !changed {
+			t.Error("Expected the file to be changed")
+		}
+
+		content, err := os.ReadFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Failed to read fixed file: %v", err)
+		}
+		if string(content) != ordered {
+			t.Errorf("Unexpected fixed content:\n%s", content)
+		}
+
+		errors, err := linter.LintFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error linting fixed file, got: %v", err)
+		}
+		if len(errors) > 0 {
+			t.Errorf("Expected no alphabetize errors after fixing, got %d", len(errors))
+		}
+	})
+
+	t.Run("should not touch files when no fixable rule fired", func(t *testing.T) {
+		linter := New()
+		linter.SetRules([]string{"alphabetize"})
+
+		tmpFile, err := createTempSchemaFile(t, ordered)
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(tmpFile) }()
+
+		changed, err := linter.FixFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error fixing file, got: %v", err)
+		}
+		if changed {
+			t.Error("Expected the file to be left unchanged")
+		}
+	})
+
+	t.Run("should only apply fixes of enabled rules", func(t *testing.T) {
+		linter := New()
+		linter.SetRules([]string{"types-have-descriptions"})
+
+		tmpFile, err := createTempSchemaFile(t, unordered)
+		if err != nil {
+			t.Fatalf("Failed to create temp file: %v", err)
+		}
+		defer func() { _ = os.Remove(tmpFile) }()
+
+		changed, err := linter.FixFile(tmpFile)
+		if err != nil {
+			t.Fatalf("Expected no error fixing file, got: %v", err)
+		}
+		if changed {
+			t.Error("Expected disabled fixable rules not to change the file")
+		}
+	})
+}
+
 func TestLoadCustomRules(t *testing.T) {
 	linter := New()
 	initialRuleCount := len(linter.rules)
@@ -387,6 +1058,103 @@ func TestLoadPlugin(t *testing.T) {
 	})
 }
 
+// stubRule is a minimal rule used to exercise plugin registration
+type stubRule struct {
+	name string
+}
+
+func (r *stubRule) Name() string        { return r.name }
+func (r *stubRule) Description() string { return "stub rule" }
+func (r *stubRule) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	return nil
+}
+
+// stubLookup returns a plugin lookup function backed by a map of symbols
+func stubLookup(symbols map[string]plugin.Symbol) func(string) (plugin.Symbol, error) {
+	return func(name string) (plugin.Symbol, error) {
+		if symbol, ok := symbols[name]; ok {
+			return symbol, nil
+		}
+		return nil, fmt.Errorf("symbol %s not found", name)
+	}
+}
+
+func TestRegisterPluginRules(t *testing.T) {
+	newRule := func() types.Rule { return &stubRule{name: "single-rule"} }
+
+	t.Run("should register a NewRule plugin without a version", func(t *testing.T) {
+		linter := New()
+		initialRuleCount := len(linter.rules)
+
+		err := linter.registerPluginRules("legacy.so", stubLookup(map[string]plugin.Symbol{
+			"NewRule": newRule,
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if len(linter.rules) != initialRuleCount+1 {
+			t.Errorf("Expected 1 rule to be registered, got %d", len(linter.rules)-initialRuleCount)
+		}
+	})
+
+	t.Run("should register every rule in an exported Rules slice", func(t *testing.T) {
+		linter := New()
+		initialRuleCount := len(linter.rules)
+
+		version := PluginAPIVersion
+		pluginRules := []types.Rule{&stubRule{name: "rule-a"}, &stubRule{name: "rule-b"}}
+		err := linter.registerPluginRules("multi.so", stubLookup(map[string]plugin.Symbol{
+			"PluginAPIVersion": &version,
+			"Rules":            &pluginRules,
+			"NewRule":          newRule,
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if len(linter.rules) != initialRuleCount+3 {
+			t.Errorf("Expected 3 rules to be registered, got %d", len(linter.rules)-initialRuleCount)
+		}
+	})
+
+	t.Run("should reject a plugin targeting another API version", func(t *testing.T) {
+		linter := New()
+
+		version := PluginAPIVersion + 1
+		err := linter.registerPluginRules("future.so", stubLookup(map[string]plugin.Symbol{
+			"PluginAPIVersion": &version,
+			"NewRule":          newRule,
+		}))
+		if err == nil {
+			t.Fatal("Expected error for mismatched API version")
+		}
+
+		expected := fmt.Sprintf("plugin future.so targets API version %d but this linter supports version %d", version, PluginAPIVersion)
+		if err.Error() != expected {
+			t.Errorf("Expected error %q, got %q", expected, err.Error())
+		}
+	})
+
+	t.Run("should fail when the plugin exports no rules", func(t *testing.T) {
+		linter := New()
+
+		err := linter.registerPluginRules("empty.so", stubLookup(map[string]plugin.Symbol{}))
+		if err == nil || !strings.Contains(err.Error(), "plugin must export NewRule function or Rules slice") {
+			t.Errorf("Expected missing export error, got: %v", err)
+		}
+	})
+
+	t.Run("should fail on symbols with the wrong type", func(t *testing.T) {
+		linter := New()
+
+		err := linter.registerPluginRules("bad.so", stubLookup(map[string]plugin.Symbol{
+			"Rules": []string{"not-a-rule"},
+		}))
+		if err == nil {
+			t.Error("Expected error for mistyped Rules symbol")
+		}
+	})
+}
+
 // Helper function to create temporary schema files for testing
 func createTempSchemaFile(t *testing.T, content string) (string, error) {
 	tmpFile, err := os.CreateTemp("", "test-schema-*.graphql")
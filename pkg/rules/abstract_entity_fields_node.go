@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// AbstractEntityFieldsNode checks that interface and union typed fields whose implementers are entities use the Node pattern
+type AbstractEntityFieldsNode struct{}
+
+// NewAbstractEntityFieldsNode creates a new instance of the AbstractEntityFieldsNode rule
+func NewAbstractEntityFieldsNode() *AbstractEntityFieldsNode {
+	return &AbstractEntityFieldsNode{}
+}
+
+// Name returns the rule name
+func (r *AbstractEntityFieldsNode) Name() string {
+	return "abstract-entity-fields-node"
+}
+
+// Description returns what this rule checks
+func (r *AbstractEntityFieldsNode) Description() string {
+	return "Interface and union typed fields whose implementers are entities should return Node or have Node implementers so clients can refetch them globally (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *AbstractEntityFieldsNode) OptIn() bool {
+	return true
+}
+
+// Check validates that abstract fields resolving to entities don't bypass the Node pattern
+func (r *AbstractEntityFieldsNode) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			returnType := schema.Types[field.Type.Name()]
+			if returnType == nil || returnType.Name == "Node" {
+				continue
+			}
+			if returnType.Kind != ast.Interface && returnType.Kind != ast.Union {
+				continue
+			}
+
+			if !r.hasNonNodeEntityImplementer(schema, returnType) {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s.%s` returns `%s` whose implementers are entities but aren't Node types; enable global refetch.", def.Name, field.Name, returnType.Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// hasNonNodeEntityImplementer checks if any concrete implementer of an abstract type is an entity that doesn't implement Node
+func (r *AbstractEntityFieldsNode) hasNonNodeEntityImplementer(schema *ast.Schema, abstractType *ast.Definition) bool {
+	for _, implementer := range schema.GetPossibleTypes(abstractType) {
+		if implementer.Kind != ast.Object {
+			continue
+		}
+		if hasKeyDirective(implementer) && !r.implementsNode(implementer) {
+			return true
+		}
+	}
+	return false
+}
+
+// implementsNode checks if a type declares the Node interface
+func (r *AbstractEntityFieldsNode) implementsNode(def *ast.Definition) bool {
+	for _, interfaceName := range def.Interfaces {
+		if interfaceName == "Node" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package rules
+
+import "testing"
+
+func TestAbstractEntityFieldsNode(t *testing.T) {
+	rule := NewAbstractEntityFieldsNode()
+
+	if !rule.OptIn() {
+		t.Error("Expected abstract-entity-fields-node to be opt-in")
+	}
+
+	t.Run("should flag abstract fields resolving to non-Node entities", func(t *testing.T) {
+		schema := `
+		directive @key(fields: String!) on OBJECT
+
+		interface FeedItem {
+			id: ID!
+		}
+
+		type Post implements FeedItem @key(fields: "id") {
+			id: ID!
+		}
+
+		type Photo @key(fields: "id") {
+			id: ID!
+		}
+
+		union Media = Photo
+
+		type Feed {
+			item: FeedItem
+			media: [Media!]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "abstract-entity-fields-node") != 2 {
+			t.Errorf("Expected 2 errors, got %d", countRuleErrors(errors, "abstract-entity-fields-node"))
+		}
+
+		expectedMessage := "Field `Feed.item` returns `FeedItem` whose implementers are entities but aren't Node types; enable global refetch."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should pass when entities implement Node or the field returns Node", func(t *testing.T) {
+		schema := `
+		directive @key(fields: String!) on OBJECT
+
+		interface Node {
+			id: ID!
+		}
+
+		interface FeedItem {
+			id: ID!
+		}
+
+		type Post implements Node & FeedItem @key(fields: "id") {
+			id: ID!
+		}
+
+		type Banner implements FeedItem {
+			id: ID!
+		}
+
+		type Feed {
+			item: FeedItem
+			node: Node
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "abstract-entity-fields-node") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "abstract-entity-fields-node"))
+		}
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/anirudhraja/gqllinter/pkg/types"
 	"github.com/nishant-rn/gqlparser/v2/ast"
+	"github.com/nishant-rn/gqlparser/v2/parser"
 )
 
 // Alphabetize checks that fields and enum values are in alphabetical order
@@ -51,24 +52,16 @@ func (r *Alphabetize) Check(schema *ast.Schema, source *ast.Source) []types.Lint
 
 			// Check if fields are alphabetically ordered
 			if len(fieldNames) > 1 && !r.isAlphabeticallyOrdered(fieldNames) {
-				line, column := 1, 1
-				if def.Position != nil {
-					line = def.Position.Line
-					column = def.Position.Column
-				}
+				location := nameLocation(source, def.Position, def.Name)
 
 				sortedNames := make([]string, len(fieldNames))
 				copy(sortedNames, fieldNames)
 				sort.Strings(sortedNames)
 
 				errors = append(errors, types.LintError{
-					Message: fmt.Sprintf("Fields in type `%s` should be alphabetically ordered. Expected order: [%s]", def.Name, strings.Join(sortedNames, ", ")),
-					Location: types.Location{
-						Line:   line,
-						Column: column,
-						File:   source.Name,
-					},
-					Rule: r.Name(),
+					Message:  fmt.Sprintf("Fields in type `%s` should be alphabetically ordered. Expected order: [%s]", def.Name, strings.Join(sortedNames, ", ")),
+					Location: location,
+					Rule:     r.Name(),
 				})
 			}
 		}
@@ -83,24 +76,16 @@ func (r *Alphabetize) Check(schema *ast.Schema, source *ast.Source) []types.Lint
 
 			// Check if enum values are alphabetically ordered
 			if !r.isAlphabeticallyOrdered(enumNames) {
-				line, column := 1, 1
-				if def.Position != nil {
-					line = def.Position.Line
-					column = def.Position.Column
-				}
+				location := nameLocation(source, def.Position, def.Name)
 
 				sortedNames := make([]string, len(enumNames))
 				copy(sortedNames, enumNames)
 				sort.Strings(sortedNames)
 
 				errors = append(errors, types.LintError{
-					Message: fmt.Sprintf("Enum values in `%s` should be alphabetically ordered. Expected order: [%s]", def.Name, strings.Join(sortedNames, ", ")),
-					Location: types.Location{
-						Line:   line,
-						Column: column,
-						File:   source.Name,
-					},
-					Rule: r.Name(),
+					Message:  fmt.Sprintf("Enum values in `%s` should be alphabetically ordered. Expected order: [%s]", def.Name, strings.Join(sortedNames, ", ")),
+					Location: location,
+					Rule:     r.Name(),
 				})
 			}
 		}
@@ -119,24 +104,16 @@ func (r *Alphabetize) Check(schema *ast.Schema, source *ast.Source) []types.Lint
 
 			// Check if fields are alphabetically ordered
 			if len(fieldNames) > 1 && !r.isAlphabeticallyOrdered(fieldNames) {
-				line, column := 1, 1
-				if def.Position != nil {
-					line = def.Position.Line
-					column = def.Position.Column
-				}
+				location := nameLocation(source, def.Position, def.Name)
 
 				sortedNames := make([]string, len(fieldNames))
 				copy(sortedNames, fieldNames)
 				sort.Strings(sortedNames)
 
 				errors = append(errors, types.LintError{
-					Message: fmt.Sprintf("Fields in input type `%s` should be alphabetically ordered. Expected order: [%s]", def.Name, strings.Join(sortedNames, ", ")),
-					Location: types.Location{
-						Line:   line,
-						Column: column,
-						File:   source.Name,
-					},
-					Rule: r.Name(),
+					Message:  fmt.Sprintf("Fields in input type `%s` should be alphabetically ordered. Expected order: [%s]", def.Name, strings.Join(sortedNames, ", ")),
+					Location: location,
+					Rule:     r.Name(),
 				})
 			}
 		}
@@ -160,3 +137,105 @@ func (r *Alphabetize) isAlphabeticallyOrdered(names []string) bool {
 
 	return true
 }
+
+// sortableMember is a field or enum value along with the offset where its text begins
+type sortableMember struct {
+	name  string
+	start int
+}
+
+// Fix rewrites the source so that fields and enum values within each definition are alphabetically ordered.
+// Descriptions, directives and comments attached to a member move together with it.
+func (r *Alphabetize) Fix(source *ast.Source) (string, error) {
+	doc, err := parser.ParseSchema(source)
+	if err != nil {
+		return "", err
+	}
+
+	input := []rune(source.Input)
+	var edits []textEdit
+
+	definitions := append(append(ast.DefinitionList{}, doc.Definitions...), doc.Extensions...)
+	for _, def := range definitions {
+		var members []sortableMember
+		switch def.Kind {
+		case ast.Object, ast.Interface, ast.InputObject:
+			for _, field := range def.Fields {
+				if field.Position == nil {
+					continue
+				}
+				start := leadingStart(input, field.Position, field.BeforeDescriptionComment, field.AfterDescriptionComment)
+				members = append(members, sortableMember{name: field.Name, start: start})
+			}
+		case ast.Enum:
+			for _, value := range def.EnumValues {
+				if value.Position == nil {
+					continue
+				}
+				start := leadingStart(input, value.Position, value.BeforeDescriptionComment, value.AfterDescriptionComment)
+				members = append(members, sortableMember{name: value.Name, start: start})
+			}
+		}
+
+		if edit, ok := r.sortMembers(input, members); ok {
+			edits = append(edits, edit)
+		}
+	}
+
+	return applyEdits(input, edits), nil
+}
+
+// sortMembers builds an edit that reorders the members of one definition.
+// Each member spans from its first attached comment or description up to the next member,
+// so a trailing same-line comment stays with the member before it.
+func (r *Alphabetize) sortMembers(input []rune, members []sortableMember) (textEdit, bool) {
+	names := make([]string, len(members))
+	for i, member := range members {
+		names[i] = member.name
+	}
+	if r.isAlphabeticallyOrdered(names) {
+		return textEdit{}, false
+	}
+
+	bounds := make([]int, 0, len(members)+1)
+	for _, member := range members {
+		bounds = append(bounds, member.start)
+	}
+
+	closing := closingBrace(input, members[len(members)-1].start)
+	if closing < 0 {
+		return textEdit{}, false
+	}
+	bounds = append(bounds, closing)
+
+	// Split each member's span into its text and the whitespace that follows it;
+	// the whitespace stays in place so blank lines between members are preserved
+	texts := make([]string, len(members))
+	separators := make([]string, len(members))
+	for i := range members {
+		end := contentEnd(input, bounds[i], bounds[i+1])
+		texts[i] = string(input[bounds[i]:end])
+		separators[i] = string(input[end:bounds[i+1]])
+	}
+
+	order := make([]int, len(members))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return strings.ToLower(members[order[i]].name) < strings.ToLower(members[order[j]].name)
+	})
+
+	var builder strings.Builder
+	for i, index := range order {
+		separator := separators[i]
+		// A comment runs to the end of its line, so whatever follows it has to move to the next one
+		if endsWithComment(texts[index]) && !strings.Contains(separator, "\n") {
+			separator = "\n"
+		}
+		builder.WriteString(texts[index])
+		builder.WriteString(separator)
+	}
+
+	return textEdit{start: bounds[0], end: bounds[len(bounds)-1], text: builder.String()}, true
+}
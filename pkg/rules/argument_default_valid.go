@@ -0,0 +1,139 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// ArgumentDefaultValid checks that argument default values are valid for the argument's type
+type ArgumentDefaultValid struct{}
+
+// NewArgumentDefaultValid creates a new instance of the ArgumentDefaultValid rule
+func NewArgumentDefaultValid() *ArgumentDefaultValid {
+	return &ArgumentDefaultValid{}
+}
+
+// Name returns the rule name
+func (r *ArgumentDefaultValid) Name() string {
+	return "argument-default-valid"
+}
+
+// Description returns what this rule checks
+func (r *ArgumentDefaultValid) Description() string {
+	return "Argument default values should be valid for the argument's type: enum defaults must be values of the enum, and built-in scalar defaults must match the scalar"
+}
+
+// Check validates field argument default values
+func (r *ArgumentDefaultValid) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			for _, arg := range field.Arguments {
+				if arg.DefaultValue == nil {
+					continue
+				}
+
+				value, reason := r.validateValue(schema, arg.DefaultValue, arg.Type)
+				if value == nil {
+					continue
+				}
+
+				line, column := 1, 1
+				if arg.Position != nil {
+					line = arg.Position.Line
+					column = arg.Position.Column
+				}
+
+				errors = append(errors, types.LintError{
+					Message: fmt.Sprintf("Default value `%s` for argument `%s` on `%s.%s` %s.",
+						value.String(), arg.Name, def.Name, field.Name, reason),
+					Location: types.Location{
+						Line:   line,
+						Column: column,
+						File:   source.Name,
+					},
+					Rule: r.Name(),
+				})
+			}
+		}
+	}
+
+	return errors
+}
+
+// validateValue checks a value against a type and returns the first invalid value with the reason it is invalid,
+// or nil when the value is valid. Values of custom scalars and input objects are not checked.
+func (r *ArgumentDefaultValid) validateValue(schema *ast.Schema, value *ast.Value, valueType *ast.Type) (*ast.Value, string) {
+	if value.Kind == ast.NullValue {
+		if valueType.NonNull {
+			return value, fmt.Sprintf("is not valid for the non-null type `%s`", valueType.String())
+		}
+		return nil, ""
+	}
+
+	if valueType.Elem != nil {
+		// A single value is coerced to a list of one item
+		if value.Kind != ast.ListValue {
+			return r.validateValue(schema, value, valueType.Elem)
+		}
+		for _, child := range value.Children {
+			if invalid, reason := r.validateValue(schema, child.Value, valueType.Elem); invalid != nil {
+				return invalid, reason
+			}
+		}
+		return nil, ""
+	}
+
+	typeDef := schema.Types[valueType.NamedType]
+	if typeDef == nil {
+		return nil, ""
+	}
+
+	switch typeDef.Kind {
+	case ast.Enum:
+		if value.Kind != ast.EnumValue || typeDef.EnumValues.ForName(value.Raw) == nil {
+			return value, fmt.Sprintf("is not a value of enum `%s`", typeDef.Name)
+		}
+	case ast.Scalar:
+		if !r.matchesScalar(typeDef.Name, value.Kind) {
+			return value, fmt.Sprintf("is not a valid `%s`", typeDef.Name)
+		}
+	}
+
+	return nil, ""
+}
+
+// matchesScalar checks if a value kind is valid for a built-in scalar; custom scalars accept any value
+func (r *ArgumentDefaultValid) matchesScalar(scalarName string, kind ast.ValueKind) bool {
+	switch scalarName {
+	case "Int":
+		return kind == ast.IntValue
+	case "Float":
+		return kind == ast.IntValue || kind == ast.FloatValue
+	case "String":
+		return kind == ast.StringValue || kind == ast.BlockValue
+	case "Boolean":
+		return kind == ast.BooleanValue
+	case "ID":
+		return kind == ast.StringValue || kind == ast.BlockValue || kind == ast.IntValue
+	default:
+		return true
+	}
+}
@@ -0,0 +1,85 @@
+package rules
+
+import "testing"
+
+func TestArgumentDefaultValid(t *testing.T) {
+	rule := NewArgumentDefaultValid()
+
+	t.Run("should allow valid defaults", func(t *testing.T) {
+		schema := `
+		scalar DateTime
+
+		enum SortOrder {
+			ASCENDING
+			DESCENDING
+		}
+
+		type Query {
+			users(
+				sort: SortOrder = ASCENDING
+				sorts: [SortOrder!] = [ASCENDING, DESCENDING]
+				first: Int = 10
+				ratio: Float = 1
+				name: String = "all"
+				id: ID = 42
+				active: Boolean = true
+				after: String = null
+				since: DateTime = "2024-01-01"
+			): [String]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "argument-default-valid") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag enum defaults that are not enum values", func(t *testing.T) {
+		schema := `
+		enum SortOrder {
+			ASC
+			DESC
+		}
+
+		type Query {
+			users(sort: SortOrder = DESCENDING): [String]
+			posts(sorts: [SortOrder!] = [ASC, DESCENDING]): [String]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Default value `DESCENDING` for argument `sort` on `Query.users` is not a value of enum `SortOrder`.",
+			"Default value `DESCENDING` for argument `sorts` on `Query.posts` is not a value of enum `SortOrder`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "argument-default-valid") != 2 {
+			t.Errorf("Expected 2 errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag scalar defaults of the wrong kind", func(t *testing.T) {
+		schema := `
+		type Query {
+			users(first: Int = "ten", active: Boolean = 1, limit: Int! = null): [String]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Default value `\"ten\"` for argument `first` on `Query.users` is not a valid `Int`.",
+			"Default value `1` for argument `active` on `Query.users` is not a valid `Boolean`.",
+			"Default value `null` for argument `limit` on `Query.users` is not valid for the non-null type `Int!`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "argument-default-valid") != 3 {
+			t.Errorf("Expected 3 errors, got %v", errors)
+		}
+	})
+}
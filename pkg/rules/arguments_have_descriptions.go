@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// ArgumentsHaveDescriptions checks that all field arguments have descriptions
+type ArgumentsHaveDescriptions struct {
+	includeDirectives bool
+}
+
+// NewArgumentsHaveDescriptions creates a new instance of the ArgumentsHaveDescriptions rule.
+// If includeDirectives is true, arguments of directive definitions are checked as well.
+func NewArgumentsHaveDescriptions(includeDirectives bool) *ArgumentsHaveDescriptions {
+	return &ArgumentsHaveDescriptions{includeDirectives: includeDirectives}
+}
+
+// Name returns the rule name
+func (r *ArgumentsHaveDescriptions) Name() string {
+	return "arguments-have-descriptions"
+}
+
+// Description returns what this rule checks
+func (r *ArgumentsHaveDescriptions) Description() string {
+	return "All field arguments should have descriptions to explain their purpose"
+}
+
+// Check validates that all arguments have descriptions
+func (r *ArgumentsHaveDescriptions) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			for _, arg := range field.Arguments {
+				if arg.Description != "" {
+					continue
+				}
+				errors = append(errors, r.buildError(source, arg.Position, fmt.Sprintf("%s.%s(%s:)", def.Name, field.Name, arg.Name)))
+			}
+		}
+	}
+
+	if r.includeDirectives {
+		for _, directive := range schema.Directives {
+			// Skip directives defined by the GraphQL specification
+			if directive.Position == nil || directive.Position.Src == nil || directive.Position.Src.BuiltIn {
+				continue
+			}
+
+			for _, arg := range directive.Arguments {
+				if arg.Description != "" {
+					continue
+				}
+				errors = append(errors, r.buildError(source, arg.Position, fmt.Sprintf("@%s(%s:)", directive.Name, arg.Name)))
+			}
+		}
+	}
+
+	return errors
+}
+
+// buildError creates the missing description error for an argument
+func (r *ArgumentsHaveDescriptions) buildError(source *ast.Source, position *ast.Position, argument string) types.LintError {
+	line, column := 1, 1
+	if position != nil {
+		line = position.Line
+		column = position.Column
+	}
+
+	return types.LintError{
+		Message: fmt.Sprintf("The argument `%s` is missing a description.", argument),
+		Location: types.Location{
+			Line:   line,
+			Column: column,
+			File:   source.Name,
+		},
+		Rule: r.Name(),
+	}
+}
@@ -0,0 +1,55 @@
+package rules
+
+import "testing"
+
+func TestArgumentsHaveDescriptions(t *testing.T) {
+	schema := `
+	directive @cache(ttl: Int, "Cache scope" scope: String) on FIELD_DEFINITION
+
+	type User {
+		id: ID!
+	}
+
+	interface Searchable {
+		search(term: String): [User!]!
+	}
+
+	type Query {
+		users("Number of users to return" first: Int, after: String): [User!]! @cache(ttl: 60)
+		version: String
+	}
+	`
+
+	t.Run("should flag arguments without descriptions", func(t *testing.T) {
+		errors := runRule(t, NewArgumentsHaveDescriptions(false), schema)
+		if countRuleErrors(errors, "arguments-have-descriptions") != 2 {
+			t.Errorf("Expected 2 errors for undocumented arguments, got %d", countRuleErrors(errors, "arguments-have-descriptions"))
+		}
+
+		expectedMessages := []string{
+			"The argument `Query.users(after:)` is missing a description.",
+			"The argument `Searchable.search(term:)` is missing a description.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+		if containsError(errors, "The argument `Query.users(first:)` is missing a description.") {
+			t.Error("Expected documented argument to pass")
+		}
+	})
+
+	t.Run("should check directive arguments when configured", func(t *testing.T) {
+		errors := runRule(t, NewArgumentsHaveDescriptions(true), schema)
+		if countRuleErrors(errors, "arguments-have-descriptions") != 3 {
+			t.Errorf("Expected 3 errors including directive arguments, got %d", countRuleErrors(errors, "arguments-have-descriptions"))
+		}
+		if !containsError(errors, "The argument `@cache(ttl:)` is missing a description.") {
+			t.Error("Expected directive argument to be flagged")
+		}
+		if containsError(errors, "The argument `@deprecated(reason:)` is missing a description.") {
+			t.Error("Expected built-in directives to be skipped")
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// AtLeastOneNonNullField checks that object types have at least one non-null field.
+// This pulls against fields-nullable-except-id, which asks for nullable fields; with both
+// enabled, the identifying field is the one expected to stay non-null.
+type AtLeastOneNonNullField struct {
+	mutationLint *MutationLint
+}
+
+// NewAtLeastOneNonNullField creates a new instance of the AtLeastOneNonNullField rule
+func NewAtLeastOneNonNullField() *AtLeastOneNonNullField {
+	return &AtLeastOneNonNullField{mutationLint: NewMutationLint()}
+}
+
+// Name returns the rule name
+func (r *AtLeastOneNonNullField) Name() string {
+	return "at-least-one-non-null-field"
+}
+
+// Description returns what this rule checks
+func (r *AtLeastOneNonNullField) Description() string {
+	return "Object types should have at least one non-null field, typically the identifying one; complements fields-nullable-except-id, which makes every other field nullable (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *AtLeastOneNonNullField) OptIn() bool {
+	return true
+}
+
+// Check validates that each object type has a non-null field
+func (r *AtLeastOneNonNullField) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object || r.isExempt(schema, def) {
+			continue
+		}
+
+		hasNonNull := false
+		for _, field := range def.Fields {
+			if !strings.HasPrefix(field.Name, "__") && field.Type.NonNull {
+				hasNonNull = true
+				break
+			}
+		}
+		if hasNonNull {
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Type `%s` has no non-null fields; at least the identifying field should be non-null.", def.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// isExempt checks if a type is a root type, a pagination helper or a @responseUnion virtual union
+func (r *AtLeastOneNonNullField) isExempt(schema *ast.Schema, def *ast.Definition) bool {
+	if def == schema.Query || def == schema.Mutation || def == schema.Subscription {
+		return true
+	}
+	if isPaginationHelperType(def.Name) {
+		return true
+	}
+	return r.mutationLint.hasResponseUnionDirective(def)
+}
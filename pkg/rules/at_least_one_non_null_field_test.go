@@ -0,0 +1,68 @@
+package rules
+
+import "testing"
+
+func TestAtLeastOneNonNullField(t *testing.T) {
+	rule := NewAtLeastOneNonNullField()
+
+	t.Run("should allow types with a non-null field and exempt helpers", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			name: String
+		}
+
+		type PageInfo {
+			hasNextPage: Boolean
+			endCursor: String
+		}
+
+		type UserEdge {
+			node: User
+			cursor: String
+		}
+
+		type UserConnection {
+			edges: [UserEdge]
+			pageInfo: PageInfo
+		}
+
+		type Query {
+			users: UserConnection
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "at-least-one-non-null-field") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag types without non-null fields", func(t *testing.T) {
+		schema := `
+		type Address {
+			street: String
+			city: String
+		}
+
+		type User {
+			id: ID!
+			address: Address
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Type `Address` has no non-null fields; at least the identifying field should be non-null."
+		if countRuleErrors(errors, "at-least-one-non-null-field") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
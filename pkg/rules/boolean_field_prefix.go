@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultBooleanFieldPrefixes are the predicate prefixes accepted by NewBooleanFieldPrefix when no list is configured
+var DefaultBooleanFieldPrefixes = []string{"is", "has", "can", "should", "was", "will"}
+
+// BooleanFieldPrefix checks that Boolean fields are named as predicates
+type BooleanFieldPrefix struct {
+	prefixes []string
+}
+
+// NewBooleanFieldPrefix creates a new instance of the BooleanFieldPrefix rule.
+// If prefixes is empty, DefaultBooleanFieldPrefixes is used.
+func NewBooleanFieldPrefix(prefixes []string) *BooleanFieldPrefix {
+	if len(prefixes) == 0 {
+		prefixes = DefaultBooleanFieldPrefixes
+	}
+	return &BooleanFieldPrefix{prefixes: prefixes}
+}
+
+// Name returns the rule name
+func (r *BooleanFieldPrefix) Name() string {
+	return "boolean-field-prefix"
+}
+
+// Description returns what this rule checks
+func (r *BooleanFieldPrefix) Description() string {
+	return fmt.Sprintf("Boolean fields on objects and interfaces (other than mutations and subscriptions) should start with a predicate prefix (%s)", strings.Join(r.prefixes, ", "))
+}
+
+// Check validates that Boolean fields start with a predicate prefix
+func (r *BooleanFieldPrefix) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		// Mutation and subscription fields name operations, not predicates
+		if def == schema.Mutation || def == schema.Subscription {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			if field.Type.NamedType != "Boolean" || r.hasPrefix(field.Name) {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Boolean field `%s.%s` should be prefixed, e.g. `%s%s`.", def.Name, field.Name, r.prefixes[0], strings.ToUpper(field.Name[:1])+field.Name[1:]),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// hasPrefix checks if the name begins with a configured prefix as a whole camelCase word
+func (r *BooleanFieldPrefix) hasPrefix(name string) bool {
+	for _, prefix := range r.prefixes {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		rest := name[len(prefix):]
+		if rest != "" && !unicode.IsLower(rune(rest[0])) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package rules
+
+import "testing"
+
+func TestBooleanFieldPrefix(t *testing.T) {
+	t.Run("should allow prefixed Boolean fields", func(t *testing.T) {
+		rule := NewBooleanFieldPrefix(nil)
+		schema := `
+		type User {
+			isActive: Boolean!
+			hasAvatar: Boolean
+			canEdit: Boolean
+			name: String
+		}
+
+		type Query {
+			user(active: Boolean): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "boolean-field-prefix") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag unprefixed Boolean fields", func(t *testing.T) {
+		rule := NewBooleanFieldPrefix(nil)
+		schema := `
+		type User {
+			active: Boolean!
+			island: Boolean
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "boolean-field-prefix") != 2 {
+			t.Errorf("Expected 2 errors for unprefixed Boolean fields, got %d", countRuleErrors(errors, "boolean-field-prefix"))
+		}
+
+		expectedMessage := "Boolean field `User.active` should be prefixed, e.g. `isActive`."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should skip mutation and subscription fields", func(t *testing.T) {
+		rule := NewBooleanFieldPrefix(nil)
+		schema := `
+		type Query {
+			isReady: Boolean
+		}
+
+		type Mutation {
+			deleteUser(id: ID!): Boolean
+		}
+
+		type Subscription {
+			heartbeat: Boolean!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "boolean-field-prefix") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should use configured prefixes", func(t *testing.T) {
+		rule := NewBooleanFieldPrefix([]string{"does"})
+		schema := `
+		type User {
+			doesShip: Boolean
+			isActive: Boolean
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Boolean field `User.isActive` should be prefixed, e.g. `doesIsActive`."
+		if countRuleErrors(errors, "boolean-field-prefix") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
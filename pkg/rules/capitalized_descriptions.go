@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/anirudhraja/gqllinter/pkg/types"
 	"github.com/nishant-rn/gqlparser/v2/ast"
@@ -180,6 +181,11 @@ func (r *CapitalizedDescriptions) isCapitalized(description string) bool {
 		return true // Empty descriptions are fine
 	}
 
-	firstChar := rune(trimmed[0])
+	// Descriptions may start with a `code` identifier, a number or punctuation,
+	// which have no case; only a leading letter must be uppercase
+	firstChar, _ := utf8.DecodeRuneInString(trimmed)
+	if !unicode.IsLetter(firstChar) {
+		return true
+	}
 	return unicode.IsUpper(firstChar)
 }
@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// ConnectionEdgesNonNull checks that the `edges` field of Connection types is a non-null list of non-null edges
+type ConnectionEdgesNonNull struct{}
+
+// NewConnectionEdgesNonNull creates a new instance of the ConnectionEdgesNonNull rule
+func NewConnectionEdgesNonNull() *ConnectionEdgesNonNull {
+	return &ConnectionEdgesNonNull{}
+}
+
+// Name returns the rule name
+func (r *ConnectionEdgesNonNull) Name() string {
+	return "connection-edges-non-null"
+}
+
+// Description returns what this rule checks
+func (r *ConnectionEdgesNonNull) Description() string {
+	return "The `edges` field of Connection types should be a non-null list of non-null edges, e.g. `[UserEdge!]!` (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *ConnectionEdgesNonNull) OptIn() bool {
+	return true
+}
+
+// Check validates that Connection `edges` fields are `[XEdge!]!`
+func (r *ConnectionEdgesNonNull) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object || !strings.HasSuffix(strings.ToLower(def.Name), "connection") {
+			continue
+		}
+
+		edgesField := def.Fields.ForName("edges")
+		if edgesField == nil || !isListType(edgesField.Type) {
+			// Missing or non-list edges are reported by relay-connection-types
+			continue
+		}
+
+		elementType := getListElementType(edgesField.Type)
+		if edgesField.Type.NonNull && elementType != nil && elementType.NonNull && !isListType(elementType) {
+			continue
+		}
+
+		line, column := 1, 1
+		if edgesField.Position != nil {
+			line = edgesField.Position.Line
+			column = edgesField.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Connection type `%s` field `edges` should be `[%s!]!`, but is `%s`.",
+				def.Name, edgesField.Type.Name(), edgesField.Type.String()),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
@@ -0,0 +1,87 @@
+package rules
+
+import "testing"
+
+func TestConnectionEdgesNonNull(t *testing.T) {
+	rule := NewConnectionEdgesNonNull()
+
+	t.Run("should allow non-null lists of non-null edges", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+			cursor: String!
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			pageInfo: PageInfo!
+		}
+
+		type Query {
+			users: UserConnection!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "connection-edges-non-null") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag weaker edges types", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+			cursor: String!
+		}
+
+		type UserConnection {
+			edges: [UserEdge]
+			pageInfo: PageInfo!
+		}
+
+		type FriendConnection {
+			edges: [UserEdge]!
+			pageInfo: PageInfo!
+		}
+
+		type FollowerConnection {
+			edges: [UserEdge!]
+			pageInfo: PageInfo!
+		}
+
+		type Query {
+			users: UserConnection!
+			friends: FriendConnection!
+			followers: FollowerConnection!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Connection type `UserConnection` field `edges` should be `[UserEdge!]!`, but is `[UserEdge]`.",
+			"Connection type `FriendConnection` field `edges` should be `[UserEdge!]!`, but is `[UserEdge]!`.",
+			"Connection type `FollowerConnection` field `edges` should be `[UserEdge!]!`, but is `[UserEdge!]`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "connection-edges-non-null") != 3 {
+			t.Errorf("Expected 3 errors, got %v", errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// ConnectionNodeHasId checks that the node types of Connections have an id field
+type ConnectionNodeHasId struct {
+	edgeLint *RelayEdgeTypes
+}
+
+// NewConnectionNodeHasId creates a new instance of the ConnectionNodeHasId rule
+func NewConnectionNodeHasId() *ConnectionNodeHasId {
+	return &ConnectionNodeHasId{edgeLint: NewRelayEdgeTypes()}
+}
+
+// Name returns the rule name
+func (r *ConnectionNodeHasId) Name() string {
+	return "connection-node-has-id"
+}
+
+// Description returns what this rule checks
+func (r *ConnectionNodeHasId) Description() string {
+	return "Object types paginated by a Connection should have an `id` field, so clients can identify each node"
+}
+
+// Check validates that each Connection's node object type has an id field
+func (r *ConnectionNodeHasId) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object || !strings.HasSuffix(strings.ToLower(def.Name), "connection") {
+			continue
+		}
+
+		nodeType := r.findNodeType(schema, def)
+		// Scalar, enum, interface and union nodes are out of scope
+		if nodeType == nil || nodeType.Kind != ast.Object || r.edgeLint.findField(nodeType, "id") != nil {
+			continue
+		}
+
+		line, column := 1, 1
+		if nodeType.Position != nil {
+			line = nodeType.Position.Line
+			column = nodeType.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Node type `%s` behind `%s` should have an `id` field.", nodeType.Name, def.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// findNodeType follows a Connection's edges field to the type of its Edge's node field
+func (r *ConnectionNodeHasId) findNodeType(schema *ast.Schema, connection *ast.Definition) *ast.Definition {
+	edgesField := r.edgeLint.findField(connection, "edges")
+	if edgesField == nil {
+		return nil
+	}
+	edgeType := schema.Types[r.edgeLint.getEdgeTypeFromEdgesField(edgesField.Type)]
+	if edgeType == nil {
+		return nil
+	}
+	nodeField := r.edgeLint.findField(edgeType, "node")
+	if nodeField == nil {
+		return nil
+	}
+	return schema.Types[nodeField.Type.Name()]
+}
@@ -0,0 +1,71 @@
+package rules
+
+import "testing"
+
+func TestConnectionNodeHasId(t *testing.T) {
+	rule := NewConnectionNodeHasId()
+
+	t.Run("should allow nodes with an id and scalar nodes", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+			cursor: String!
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			pageInfo: PageInfo!
+		}
+
+		type TagEdge {
+			node: String!
+			cursor: String!
+		}
+
+		type TagConnection {
+			edges: [TagEdge!]!
+			pageInfo: PageInfo!
+		}
+
+		type Query {
+			users: UserConnection!
+			tags: TagConnection!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "connection-node-has-id") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag nodes without an id", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type User {
+			name: String!
+		}
+
+		type UserEdge {
+			node: User!
+			cursor: String!
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			pageInfo: PageInfo!
+		}
+
+		type Query {
+			users: UserConnection!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Node type `User` behind `UserConnection` should have an `id` field."
+		if countRuleErrors(errors, "connection-node-has-id") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
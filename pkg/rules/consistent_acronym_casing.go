@@ -0,0 +1,173 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// AcronymStyle is the casing accepted for acronyms in names
+type AcronymStyle int
+
+const (
+	// AcronymTitleCase capitalizes only the first letter of acronyms, e.g. `HttpUrl` and `userId`
+	AcronymTitleCase AcronymStyle = iota
+	// AcronymAllCaps writes known acronyms in capitals, e.g. `HTTPServer` and `httpURL`
+	AcronymAllCaps
+)
+
+// DefaultAcronyms are the acronyms recognized by NewConsistentAcronymCasing when none are configured
+var DefaultAcronyms = []string{"API", "CSS", "DNS", "HTML", "HTTP", "HTTPS", "ID", "IP", "JSON", "JWT", "SQL", "SSO", "URI", "URL", "UUID", "XML"}
+
+// ConsistentAcronymCasing checks that acronyms in type and field names are cased consistently
+type ConsistentAcronymCasing struct {
+	style    AcronymStyle
+	acronyms []string
+}
+
+// NewConsistentAcronymCasing creates a new instance of the ConsistentAcronymCasing rule.
+// With AcronymTitleCase, runs of three or more capitals are reported and known acronyms are used
+// to split them into words. With AcronymAllCaps, known acronyms that aren't in capitals are reported.
+// If acronyms is empty, DefaultAcronyms is used.
+func NewConsistentAcronymCasing(style AcronymStyle, acronyms []string) *ConsistentAcronymCasing {
+	if len(acronyms) == 0 {
+		acronyms = DefaultAcronyms
+	}
+	return &ConsistentAcronymCasing{style: style, acronyms: acronyms}
+}
+
+// Name returns the rule name
+func (r *ConsistentAcronymCasing) Name() string {
+	return "consistent-acronym-casing"
+}
+
+// Description returns what this rule checks
+func (r *ConsistentAcronymCasing) Description() string {
+	if r.style == AcronymAllCaps {
+		return "Acronyms in type and field names should be written in capitals, e.g. `httpURL`"
+	}
+	return "Acronyms in type and field names should be title-cased, e.g. `httpUrl` rather than `httpURL`"
+}
+
+// Check validates the acronym casing of type and field names
+func (r *ConsistentAcronymCasing) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+
+		if suggestion := r.normalize(def.Name, true); suggestion != def.Name {
+			errors = append(errors, r.newError(source, fmt.Sprintf("Type `%s` has an inconsistent acronym; prefer `%s`.", def.Name, suggestion), def.Position))
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			if suggestion := r.normalize(field.Name, false); suggestion != field.Name {
+				errors = append(errors, r.newError(source, fmt.Sprintf("Field `%s.%s` has an inconsistent acronym; prefer `%s`.", def.Name, field.Name, suggestion), field.Position))
+			}
+		}
+	}
+
+	return errors
+}
+
+// normalize returns name with its acronyms cased in the configured style.
+// The first word of a camelCase name (pascal is false) stays lowercase.
+func (r *ConsistentAcronymCasing) normalize(name string, pascal bool) string {
+	if r.style == AcronymAllCaps {
+		words := splitCamelCase(name)
+		for i, word := range words {
+			if (i > 0 || pascal) && contains(r.acronyms, strings.ToUpper(word)) {
+				words[i] = strings.ToUpper(word)
+			}
+		}
+		return strings.Join(words, "")
+	}
+
+	var builder strings.Builder
+	for i := 0; i < len(name); {
+		if !isUpperASCII(name[i]) {
+			builder.WriteByte(name[i])
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(name) && isUpperASCII(name[end]) {
+			end++
+		}
+		// The last capital of a run starts the next word, e.g. the `S` of `HTTPServer`
+		if end < len(name) && end-i > 1 && name[end] >= 'a' && name[end] <= 'z' {
+			end--
+		}
+
+		run := name[i:end]
+		if len(run) < 3 {
+			builder.WriteString(run)
+		} else {
+			builder.WriteString(r.titleCaseRun(run, i == 0 && !pascal))
+		}
+		i = end
+	}
+	return builder.String()
+}
+
+// titleCaseRun title-cases a run of capitals, splitting it into known acronyms where possible.
+// A run at the start of a camelCase name is lowercased.
+func (r *ConsistentAcronymCasing) titleCaseRun(run string, lower bool) string {
+	var words []string
+	for len(run) > 0 {
+		// Take the longest known acronym at the start of the run, unless the run is one itself
+		word := run
+		if !contains(r.acronyms, run) {
+			longest := ""
+			for _, acronym := range r.acronyms {
+				if strings.HasPrefix(run, acronym) && len(acronym) > len(longest) {
+					longest = acronym
+				}
+			}
+			if longest != "" {
+				word = longest
+			}
+		}
+		words = append(words, word)
+		run = run[len(word):]
+	}
+
+	for i, word := range words {
+		if i == 0 && lower {
+			words[i] = strings.ToLower(word)
+		} else {
+			words[i] = word[:1] + strings.ToLower(word[1:])
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// newError creates an error reported at position
+func (r *ConsistentAcronymCasing) newError(source *ast.Source, message string, position *ast.Position) types.LintError {
+	line, column := 1, 1
+	if position != nil {
+		line = position.Line
+		column = position.Column
+	}
+
+	return types.LintError{
+		Message: message,
+		Location: types.Location{
+			Line:   line,
+			Column: column,
+			File:   source.Name,
+		},
+		Rule: r.Name(),
+	}
+}
@@ -0,0 +1,86 @@
+package rules
+
+import "testing"
+
+func TestConsistentAcronymCasing(t *testing.T) {
+	t.Run("should allow title-cased acronyms", func(t *testing.T) {
+		rule := NewConsistentAcronymCasing(AcronymTitleCase, nil)
+		schema := `
+		type HttpServer {
+			id: ID!
+			userID: ID
+			httpUrl: String
+		}
+
+		type Query {
+			server: HttpServer
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "consistent-acronym-casing") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag runs of capitals", func(t *testing.T) {
+		rule := NewConsistentAcronymCasing(AcronymTitleCase, nil)
+		schema := `
+		type HTTPURL {
+			httpURL: String
+			URLPath: String
+		}
+
+		type HTTPSServer {
+			id: ID!
+		}
+
+		type Query {
+			url: HTTPURL
+			server: HTTPSServer
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Type `HTTPURL` has an inconsistent acronym; prefer `HttpUrl`.",
+			"Field `HTTPURL.httpURL` has an inconsistent acronym; prefer `httpUrl`.",
+			"Field `HTTPURL.URLPath` has an inconsistent acronym; prefer `urlPath`.",
+			"Type `HTTPSServer` has an inconsistent acronym; prefer `HttpsServer`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "consistent-acronym-casing") != 4 {
+			t.Errorf("Expected 4 errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag title-cased acronyms in all-caps style", func(t *testing.T) {
+		rule := NewConsistentAcronymCasing(AcronymAllCaps, nil)
+		schema := `
+		type HttpServer {
+			id: ID!
+			httpUrl: String
+			userURL: String
+		}
+
+		type Query {
+			server: HttpServer
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Type `HttpServer` has an inconsistent acronym; prefer `HTTPServer`.",
+			"Field `HttpServer.httpUrl` has an inconsistent acronym; prefer `httpURL`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "consistent-acronym-casing") != 2 {
+			t.Errorf("Expected 2 errors, got %v", errors)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// ConsistentCollectionExposure checks that types paginated through a Connection aren't also exposed as bare lists
+type ConsistentCollectionExposure struct {
+	edgeLint *RelayEdgeTypes
+}
+
+// NewConsistentCollectionExposure creates a new instance of the ConsistentCollectionExposure rule
+func NewConsistentCollectionExposure() *ConsistentCollectionExposure {
+	return &ConsistentCollectionExposure{edgeLint: NewRelayEdgeTypes()}
+}
+
+// Name returns the rule name
+func (r *ConsistentCollectionExposure) Name() string {
+	return "consistent-collection-exposure"
+}
+
+// Description returns what this rule checks
+func (r *ConsistentCollectionExposure) Description() string {
+	return "Types that are Connection nodes should not also be exposed as bare lists elsewhere (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *ConsistentCollectionExposure) OptIn() bool {
+	return true
+}
+
+// Check validates that paginated types are only exposed through Connections
+func (r *ConsistentCollectionExposure) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	connections := r.findConnections(schema)
+	if len(connections) == 0 {
+		return errors
+	}
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+		// Connections may offer a `nodes` shortcut next to `edges`
+		if r.isConnectionType(def) {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			connectionNames := connections[field.Type.Name()]
+			if len(connectionNames) == 0 || !isListType(field.Type) {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Type `%s` is exposed both via `%s` and as a bare list on `%s.%s`; choose one pattern.",
+					field.Type.Name(), connectionNames[0], def.Name, field.Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// findConnections maps each Connection node type to the sorted names of the Connections that paginate it
+func (r *ConsistentCollectionExposure) findConnections(schema *ast.Schema) map[string][]string {
+	connections := make(map[string][]string)
+	for _, def := range schema.Types {
+		if def.BuiltIn || !r.isConnectionType(def) {
+			continue
+		}
+
+		edgesField := r.edgeLint.findField(def, "edges")
+		if edgesField == nil {
+			continue
+		}
+		edgeType := schema.Types[r.edgeLint.getEdgeTypeFromEdgesField(edgesField.Type)]
+		if edgeType == nil {
+			continue
+		}
+		if nodeField := r.edgeLint.findField(edgeType, "node"); nodeField != nil {
+			nodeTypeName := nodeField.Type.Name()
+			connections[nodeTypeName] = append(connections[nodeTypeName], def.Name)
+		}
+	}
+
+	for _, names := range connections {
+		sort.Strings(names)
+	}
+	return connections
+}
+
+// isConnectionType checks if a type is a Connection object type by name
+func (r *ConsistentCollectionExposure) isConnectionType(def *ast.Definition) bool {
+	return def.Kind == ast.Object && strings.HasSuffix(strings.ToLower(def.Name), "connection")
+}
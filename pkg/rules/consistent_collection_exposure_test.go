@@ -0,0 +1,82 @@
+package rules
+
+import "testing"
+
+func TestConsistentCollectionExposure(t *testing.T) {
+	rule := NewConsistentCollectionExposure()
+
+	t.Run("should allow types exposed only through connections", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+			cursor: String!
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			nodes: [User!]!
+			pageInfo: PageInfo!
+		}
+
+		type Tag {
+			name: String!
+		}
+
+		type Team {
+			members: UserConnection!
+			tags: [Tag!]!
+		}
+
+		type Query {
+			team: Team
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "consistent-collection-exposure") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag bare lists of connection nodes", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+			cursor: String!
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			pageInfo: PageInfo!
+		}
+
+		type Team {
+			members: [User!]!
+			owner: User
+		}
+
+		type Query {
+			users: UserConnection!
+			team: Team
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Type `User` is exposed both via `UserConnection` and as a bare list on `Team.members`; choose one pattern."
+		if countRuleErrors(errors, "consistent-collection-exposure") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
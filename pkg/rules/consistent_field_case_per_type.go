@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+const (
+	camelCaseStyle = "camelCase"
+	snakeCaseStyle = "snake_case"
+)
+
+// ConsistentFieldCasePerType checks that the fields of a type don't mix camelCase and snake_case
+type ConsistentFieldCasePerType struct{}
+
+// NewConsistentFieldCasePerType creates a new instance of the ConsistentFieldCasePerType rule
+func NewConsistentFieldCasePerType() *ConsistentFieldCasePerType {
+	return &ConsistentFieldCasePerType{}
+}
+
+// Name returns the rule name
+func (r *ConsistentFieldCasePerType) Name() string {
+	return "consistent-field-case-per-type"
+}
+
+// Description returns what this rule checks
+func (r *ConsistentFieldCasePerType) Description() string {
+	return "Fields within a type should not mix camelCase and snake_case; minority-style fields are flagged against the type's majority style"
+}
+
+// Check validates that each type uses a single field naming style
+func (r *ConsistentFieldCasePerType) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface && def.Kind != ast.InputObject {
+			continue
+		}
+
+		// Count styles; single-word names like `id` fit either style and are not counted
+		counts := make(map[string]int)
+		for _, field := range def.Fields {
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+			if style := r.fieldCase(field.Name); style != "" {
+				counts[style]++
+			}
+		}
+		if counts[camelCaseStyle] == 0 || counts[snakeCaseStyle] == 0 {
+			continue
+		}
+
+		// Ties are resolved in favor of camelCase
+		majority, minority := camelCaseStyle, snakeCaseStyle
+		if counts[snakeCaseStyle] > counts[camelCaseStyle] {
+			majority, minority = snakeCaseStyle, camelCaseStyle
+		}
+
+		for _, field := range def.Fields {
+			if r.fieldCase(field.Name) != minority {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s.%s` uses %s while the rest of `%s` uses %s.", def.Name, field.Name, minority, def.Name, majority),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// fieldCase returns the naming style of a lowercase-initial field name, or "" if it is ambiguous or neither
+func (r *ConsistentFieldCasePerType) fieldCase(name string) string {
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		return ""
+	}
+
+	hasUpper := strings.ToLower(name) != name
+	hasUnderscore := strings.Contains(name, "_")
+	switch {
+	case hasUnderscore && !hasUpper:
+		return snakeCaseStyle
+	case hasUpper && !hasUnderscore:
+		return camelCaseStyle
+	default:
+		return ""
+	}
+}
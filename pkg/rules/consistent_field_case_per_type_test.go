@@ -0,0 +1,70 @@
+package rules
+
+import "testing"
+
+func TestConsistentFieldCasePerType(t *testing.T) {
+	rule := NewConsistentFieldCasePerType()
+
+	t.Run("should flag fields using the minority style", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			firstName: String
+			lastName: String
+			display_name: String
+		}
+
+		input LegacyFilter {
+			created_after: String
+			created_before: String
+			pageSize: Int
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "consistent-field-case-per-type") != 2 {
+			t.Errorf("Expected 2 errors for mixed case fields, got %d", countRuleErrors(errors, "consistent-field-case-per-type"))
+		}
+
+		expectedMessages := []string{
+			"Field `User.display_name` uses snake_case while the rest of `User` uses camelCase.",
+			"Field `LegacyFilter.pageSize` uses camelCase while the rest of `LegacyFilter` uses snake_case.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should flag against camelCase on a tie", func(t *testing.T) {
+		schema := `
+		type Profile {
+			avatarUrl: String
+			bio_text: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if !containsError(errors, "Field `Profile.bio_text` uses snake_case while the rest of `Profile` uses camelCase.") {
+			t.Error("Expected the snake_case field to be flagged on a tie")
+		}
+	})
+
+	t.Run("should pass consistent types and ignore single-word fields", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			name: String
+			firstName: String
+		}
+
+		type Legacy {
+			id: ID!
+			created_at: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "consistent-field-case-per-type") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "consistent-field-case-per-type"))
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// ConsistentFieldNullability checks that fields with the same name agree on nullability across object types
+type ConsistentFieldNullability struct{}
+
+// NewConsistentFieldNullability creates a new instance of the ConsistentFieldNullability rule
+func NewConsistentFieldNullability() *ConsistentFieldNullability {
+	return &ConsistentFieldNullability{}
+}
+
+// Name returns the rule name
+func (r *ConsistentFieldNullability) Name() string {
+	return "consistent-field-nullability"
+}
+
+// Description returns what this rule checks
+func (r *ConsistentFieldNullability) Description() string {
+	return "Fields with the same name should be either non-null on every object type or nullable on every object type (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *ConsistentFieldNullability) OptIn() bool {
+	return true
+}
+
+// fieldOccurrence is a field together with the object type that declares it
+type fieldOccurrence struct {
+	typeName string
+	field    *ast.FieldDefinition
+}
+
+// Check validates that same-named fields have consistent nullability
+func (r *ConsistentFieldNullability) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	nonNull := make(map[string][]fieldOccurrence)
+	nullable := make(map[string][]fieldOccurrence)
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			occurrence := fieldOccurrence{typeName: def.Name, field: field}
+			if field.Type.NonNull {
+				nonNull[field.Name] = append(nonNull[field.Name], occurrence)
+			} else {
+				nullable[field.Name] = append(nullable[field.Name], occurrence)
+			}
+		}
+	}
+
+	for name, nonNullFields := range nonNull {
+		nullableFields := nullable[name]
+		if len(nullableFields) == 0 {
+			continue
+		}
+
+		// Report each group once, at its first nullable field
+		first := nullableFields[0].field
+		for _, occurrence := range nullableFields {
+			if r.offset(occurrence.field) < r.offset(first) {
+				first = occurrence.field
+			}
+		}
+
+		line, column := 1, 1
+		if first.Position != nil {
+			line = first.Position.Line
+			column = first.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Field `%s` is non-null on %s but nullable on %s; consider consistent nullability.",
+				name, r.typeList(nonNullFields), r.typeList(nullableFields)),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// typeList formats the declaring types of fields as a sorted list, e.g. "`Admin`, `User`"
+func (r *ConsistentFieldNullability) typeList(occurrences []fieldOccurrence) string {
+	names := make([]string, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		names = append(names, "`"+occurrence.typeName+"`")
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// offset returns the position of a field in its source; fields without one sort last
+func (r *ConsistentFieldNullability) offset(field *ast.FieldDefinition) int {
+	if field.Position == nil {
+		return int(^uint(0) >> 1)
+	}
+	return field.Position.Start
+}
@@ -0,0 +1,71 @@
+package rules
+
+import "testing"
+
+func TestConsistentFieldNullability(t *testing.T) {
+	rule := NewConsistentFieldNullability()
+
+	t.Run("should allow consistent nullability", func(t *testing.T) {
+		schema := `
+		type User {
+			email: String!
+			nickname: String
+		}
+
+		type Admin {
+			email: String!
+			nickname: String
+		}
+
+		type Query {
+			user: User
+			admin: Admin
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "consistent-field-nullability") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should report each inconsistent field name once", func(t *testing.T) {
+		schema := `
+		type User {
+			email: String!
+		}
+
+		type Member {
+			email: String!
+		}
+
+		type Admin {
+			email: String
+		}
+
+		type Guest {
+			email: String
+		}
+
+		type Query {
+			user: User
+			member: Member
+			admin: Admin
+			guest: Guest
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Field `email` is non-null on `Member`, `User` but nullable on `Admin`, `Guest`; consider consistent nullability."
+		if countRuleErrors(errors, "consistent-field-nullability") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+		if len(errors) == 1 && errors[0].Location.Line != 11 {
+			t.Errorf("Expected the error at the first nullable field on line 11, got line %d", errors[0].Location.Line)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
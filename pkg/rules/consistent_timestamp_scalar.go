@@ -0,0 +1,82 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultTimestampScalar is the scalar used by NewConsistentTimestampScalar when none is configured
+const DefaultTimestampScalar = "DateTime"
+
+// ConsistentTimestampScalar checks that timestamp fields all use the same scalar
+type ConsistentTimestampScalar struct {
+	scalar string
+}
+
+// NewConsistentTimestampScalar creates a new instance of the ConsistentTimestampScalar rule.
+// If scalar is empty, DefaultTimestampScalar is used.
+func NewConsistentTimestampScalar(scalar string) *ConsistentTimestampScalar {
+	if scalar == "" {
+		scalar = DefaultTimestampScalar
+	}
+	return &ConsistentTimestampScalar{scalar: scalar}
+}
+
+// Name returns the rule name
+func (r *ConsistentTimestampScalar) Name() string {
+	return "consistent-timestamp-scalar"
+}
+
+// Description returns what this rule checks
+func (r *ConsistentTimestampScalar) Description() string {
+	return fmt.Sprintf("Timestamp fields (createdAt, *At, *Time, timestamp) should use the `%s` scalar", r.scalar)
+}
+
+// Check validates that timestamp fields use the configured scalar and that the scalar is declared
+func (r *ConsistentTimestampScalar) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+	foundTimestamp := false
+
+	visitConceptFields(schema, []ScalarConcept{TimeConcept}, func(def *ast.Definition, field *ast.FieldDefinition, _ ScalarConcept) {
+		foundTimestamp = true
+		typeName := field.Type.Name()
+		if typeName == r.scalar {
+			return
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Field `%s.%s` should use the `%s` scalar, but is typed `%s`.", def.Name, field.Name, r.scalar, typeName),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	})
+
+	// Only require the scalar once the schema actually has timestamp fields
+	if foundTimestamp {
+		if scalarDef := schema.Types[r.scalar]; scalarDef == nil || scalarDef.Kind != ast.Scalar {
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Timestamp scalar `%s` is not declared in the schema; add `scalar %s`.", r.scalar, r.scalar),
+				Location: types.Location{
+					Line:   1,
+					Column: 1,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
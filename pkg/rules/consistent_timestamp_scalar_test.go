@@ -0,0 +1,76 @@
+package rules
+
+import "testing"
+
+func TestConsistentTimestampScalar(t *testing.T) {
+	rule := NewConsistentTimestampScalar("")
+
+	t.Run("should flag timestamp fields not using the configured scalar", func(t *testing.T) {
+		schema := `
+		scalar DateTime
+
+		type User {
+			createdAt: String
+			updatedAt: DateTime
+			lastLoginTime: Int!
+			timestamp: Float
+		}
+
+		input UserFilter {
+			createdAt: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "consistent-timestamp-scalar") != 4 {
+			t.Errorf("Expected 4 errors for inconsistent timestamps, got %d", countRuleErrors(errors, "consistent-timestamp-scalar"))
+		}
+
+		expectedMessages := []string{
+			"Field `User.createdAt` should use the `DateTime` scalar, but is typed `String`.",
+			"Field `User.lastLoginTime` should use the `DateTime` scalar, but is typed `Int`.",
+			"Field `User.timestamp` should use the `DateTime` scalar, but is typed `Float`.",
+			"Field `UserFilter.createdAt` should use the `DateTime` scalar, but is typed `String`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should ignore non-timestamp and object-typed fields", func(t *testing.T) {
+		schema := `
+		type Chat {
+			id: ID!
+		}
+
+		type User {
+			format: String
+			chat: Chat
+			lastSeenAt: Chat
+			flat: Boolean
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "consistent-timestamp-scalar") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "consistent-timestamp-scalar"))
+		}
+	})
+
+	t.Run("should warn when the configured scalar is not declared", func(t *testing.T) {
+		schema := `
+		scalar DateTime
+
+		type User {
+			createdAt: DateTime
+		}
+		`
+		errors := runRule(t, NewConsistentTimestampScalar("Timestamp"), schema)
+		if !containsError(errors, "Timestamp scalar `Timestamp` is not declared in the schema; add `scalar Timestamp`.") {
+			t.Error("Expected a warning for the undeclared scalar")
+		}
+		if !containsError(errors, "Field `User.createdAt` should use the `Timestamp` scalar, but is typed `DateTime`.") {
+			t.Error("Expected User.createdAt to be flagged with the configured scalar")
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// CursorFieldNaming checks that the cursor field of Edge types is named `cursor`
+type CursorFieldNaming struct {
+	edgeLint *RelayEdgeTypes
+}
+
+// NewCursorFieldNaming creates a new instance of the CursorFieldNaming rule
+func NewCursorFieldNaming() *CursorFieldNaming {
+	return &CursorFieldNaming{edgeLint: NewRelayEdgeTypes()}
+}
+
+// Name returns the rule name
+func (r *CursorFieldNaming) Name() string {
+	return "cursor-field-naming"
+}
+
+// Description returns what this rule checks
+func (r *CursorFieldNaming) Description() string {
+	return "Edge types referenced by Connection types should name their cursor field `cursor`"
+}
+
+// Check validates that Edge cursor fields are named `cursor`
+func (r *CursorFieldNaming) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	// Only Edge types referenced by a Connection's edges field are checked
+	edgeTypes := make(map[string]bool)
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object || !strings.HasSuffix(strings.ToLower(def.Name), "connection") {
+			continue
+		}
+
+		if edgesField := r.edgeLint.findField(def, "edges"); edgesField != nil {
+			if edgeTypeName := r.edgeLint.getEdgeTypeFromEdgesField(edgesField.Type); edgeTypeName != "" {
+				edgeTypes[edgeTypeName] = true
+			}
+		}
+	}
+
+	for edgeTypeName := range edgeTypes {
+		edgeType := schema.Types[edgeTypeName]
+		if edgeType == nil || edgeType.Kind != ast.Object || r.edgeLint.findField(edgeType, "cursor") != nil {
+			continue
+		}
+
+		cursorField := r.findCursorField(edgeType)
+		if cursorField == nil {
+			continue
+		}
+
+		line, column := 1, 1
+		if cursorField.Position != nil {
+			line = cursorField.Position.Line
+			column = cursorField.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Edge type `%s` should name its cursor field `cursor`, found `%s`.", edgeType.Name, cursorField.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// findCursorField returns the only `String!` field of an Edge type other than `node`.
+// When there are several candidates the cursor can't be identified, so none is returned.
+func (r *CursorFieldNaming) findCursorField(edgeType *ast.Definition) *ast.FieldDefinition {
+	var cursorField *ast.FieldDefinition
+	for _, field := range edgeType.Fields {
+		if field.Name == "node" || strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+		if !field.Type.NonNull || field.Type.NamedType != "String" {
+			continue
+		}
+		if cursorField != nil {
+			return nil
+		}
+		cursorField = field
+	}
+	return cursorField
+}
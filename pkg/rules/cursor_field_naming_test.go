@@ -0,0 +1,110 @@
+package rules
+
+import "testing"
+
+func TestCursorFieldNaming(t *testing.T) {
+	rule := NewCursorFieldNaming()
+
+	t.Run("should allow edges with a cursor field", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+			cursor: String!
+			role: String!
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			pageInfo: PageInfo!
+		}
+
+		type Query {
+			users: UserConnection!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "cursor-field-naming") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag a misnamed cursor field", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+			cur: String!
+			addedAt: Int
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			pageInfo: PageInfo!
+		}
+
+		type Query {
+			users: UserConnection!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Edge type `UserEdge` should name its cursor field `cursor`, found `cur`."
+		if countRuleErrors(errors, "cursor-field-naming") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should ignore types not referenced by a connection", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+			cur: String!
+		}
+
+		type Query {
+			edge: UserEdge
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "cursor-field-naming") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should not guess between several cursor-like fields", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+			cur: String!
+			role: String!
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			pageInfo: PageInfo!
+		}
+
+		type Query {
+			users: UserConnection!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "cursor-field-naming") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// isoDatePattern matches an ISO-8601 calendar date such as 2023-01-01, including the date part of a timestamp
+var isoDatePattern = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}`)
+
+// DeprecationPastRemoval checks that deprecations whose reason states a removal date are deleted once that date has passed
+type DeprecationPastRemoval struct {
+	now             time.Time
+	deprecationLint *RequireDeprecationReason
+}
+
+// NewDeprecationPastRemoval creates a new instance of the DeprecationPastRemoval rule
+func NewDeprecationPastRemoval() *DeprecationPastRemoval {
+	return &DeprecationPastRemoval{deprecationLint: NewRequireDeprecationReason()}
+}
+
+// Name returns the rule name
+func (r *DeprecationPastRemoval) Name() string {
+	return "deprecation-past-removal"
+}
+
+// Description returns what this rule checks
+func (r *DeprecationPastRemoval) Description() string {
+	return "Deprecated elements whose reason contains an ISO-8601 removal date should be deleted once that date has passed (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *DeprecationPastRemoval) OptIn() bool {
+	return true
+}
+
+// SetNow sets the date removal dates are compared against; the zero time means the system date
+func (r *DeprecationPastRemoval) SetNow(now time.Time) {
+	r.now = now
+}
+
+// Check validates that no deprecation is past its removal date
+func (r *DeprecationPastRemoval) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	now := r.now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	check := func(kind, subject string, directives ast.DirectiveList, position *ast.Position) {
+		directive := r.deprecationLint.findDeprecatedDirective(directives)
+		if directive == nil {
+			return
+		}
+		removal, ok := r.removalDate(r.deprecationLint.getDeprecationReason(directive))
+		if !ok || !removal.Before(today) {
+			return
+		}
+
+		line, column := 1, 1
+		if position != nil {
+			line = position.Line
+			column = position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("%s `%s` was marked for removal on %s and should now be deleted.", kind, subject, removal.Format("2006-01-02")),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			check("Field", def.Name+"."+field.Name, field.Directives, field.Position)
+
+			for _, arg := range field.Arguments {
+				check("Argument", fmt.Sprintf("%s.%s(%s:)", def.Name, field.Name, arg.Name), arg.Directives, arg.Position)
+			}
+		}
+
+		for _, enumValue := range def.EnumValues {
+			check("Enum value", def.Name+"."+enumValue.Name, enumValue.Directives, enumValue.Position)
+		}
+	}
+
+	return errors
+}
+
+// removalDate returns the latest valid ISO-8601 date in a deprecation reason
+func (r *DeprecationPastRemoval) removalDate(reason string) (time.Time, bool) {
+	var removal time.Time
+	for _, match := range isoDatePattern.FindAllString(reason, -1) {
+		date, err := time.Parse("2006-01-02", match)
+		if err == nil && date.After(removal) {
+			removal = date
+		}
+	}
+	return removal, !removal.IsZero()
+}
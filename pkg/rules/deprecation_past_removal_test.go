@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeprecationPastRemoval(t *testing.T) {
+	rule := NewDeprecationPastRemoval()
+	rule.SetNow(time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC))
+
+	t.Run("should allow future and undated deprecations", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			name: String @deprecated(reason: "Deprecated 2024-06-01; use fullName")
+			nickname: String @deprecated(reason: "Use displayName")
+			handle: String @deprecated(reason: "Remove after 2024-13-45")
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "deprecation-past-removal") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag deprecations past their removal date", func(t *testing.T) {
+		schema := `
+		enum Status {
+			ACTIVE
+			LEGACY @deprecated(reason: "Remove on 2024-01-31T00:00:00Z")
+		}
+
+		type User {
+			id: ID!
+			legacyId: ID @deprecated(reason: "Deprecated 2023-01-01; use id")
+			status: Status
+		}
+
+		type Query {
+			users(limit: Int @deprecated(reason: "Deprecated 2023-05-01, removal 2024-05-31; use first"), first: Int): [User]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Field `User.legacyId` was marked for removal on 2023-01-01 and should now be deleted.",
+			"Enum value `Status.LEGACY` was marked for removal on 2024-01-31 and should now be deleted.",
+			"Argument `Query.users(limit:)` was marked for removal on 2024-05-31 and should now be deleted.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "deprecation-past-removal") != 3 {
+			t.Errorf("Expected 3 errors, got %v", errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
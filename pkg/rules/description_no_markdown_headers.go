@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+var (
+	// markdownHeaderPattern matches an ATX markdown header line such as "## Usage"
+	markdownHeaderPattern = regexp.MustCompile(`^#{1,6}(\s|$)`)
+	// htmlTagPattern matches an opening, closing or self-closing tag of a common HTML element such as "<br/>".
+	// Other names are left alone so type notation like "List<String>" and placeholders like "<name>" pass.
+	htmlTagPattern = regexp.MustCompile(`</?(a|abbr|b|blockquote|br|code|del|div|em|h[1-6]|hr|i|img|li|ol|p|pre|s|span|strong|sub|sup|table|tbody|td|th|thead|tr|u|ul)(\s[^<>]*)?/?>`)
+)
+
+// DescriptionNoMarkdownHeaders checks that descriptions are plain prose without markdown headers or HTML tags
+type DescriptionNoMarkdownHeaders struct{}
+
+// NewDescriptionNoMarkdownHeaders creates a new instance of the DescriptionNoMarkdownHeaders rule
+func NewDescriptionNoMarkdownHeaders() *DescriptionNoMarkdownHeaders {
+	return &DescriptionNoMarkdownHeaders{}
+}
+
+// Name returns the rule name
+func (r *DescriptionNoMarkdownHeaders) Name() string {
+	return "description-no-markdown-headers"
+}
+
+// Description returns what this rule checks
+func (r *DescriptionNoMarkdownHeaders) Description() string {
+	return "Descriptions should be plain prose without markdown headers or raw HTML tags"
+}
+
+// Check validates that descriptions contain no markdown headers or HTML tags
+func (r *DescriptionNoMarkdownHeaders) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	visitDescriptions(schema, func(description, subject string, position *ast.Position) {
+		errors = append(errors, r.checkDescription(description, subject, position, source)...)
+	})
+
+	return errors
+}
+
+// visitDescriptions calls visit for every non-empty description of a user-defined type, field, argument,
+// enum value or directive. The subject names the described element, e.g. `User.email` or `Query.user(id:)`.
+func visitDescriptions(schema *ast.Schema, visit func(description, subject string, position *ast.Position)) {
+	describe := func(description, subject string, position *ast.Position) {
+		if description != "" {
+			visit(description, subject, position)
+		}
+	}
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+
+		describe(def.Description, def.Name, def.Position)
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			fieldName := def.Name + "." + field.Name
+			describe(field.Description, fieldName, field.Position)
+
+			for _, arg := range field.Arguments {
+				describe(arg.Description, fmt.Sprintf("%s(%s:)", fieldName, arg.Name), arg.Position)
+			}
+		}
+
+		for _, enumValue := range def.EnumValues {
+			describe(enumValue.Description, def.Name+"."+enumValue.Name, enumValue.Position)
+		}
+	}
+
+	for _, directive := range schema.Directives {
+		// Skip built-in directives
+		if directive.Position == nil || directive.Position.Src == nil || directive.Position.Src.BuiltIn {
+			continue
+		}
+
+		describe(directive.Description, "@"+directive.Name, directive.Position)
+	}
+}
+
+// checkDescription reports a markdown header and an HTML tag in a description, at most once each
+func (r *DescriptionNoMarkdownHeaders) checkDescription(description, subject string, position *ast.Position, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	var problems []string
+	for _, line := range strings.Split(description, "\n") {
+		if markdownHeaderPattern.MatchString(strings.TrimSpace(line)) {
+			problems = append(problems, "a markdown header")
+			break
+		}
+	}
+	if htmlTagPattern.MatchString(description) {
+		problems = append(problems, "an HTML tag")
+	}
+
+	line, column := 1, 1
+	if position != nil {
+		line = position.Line
+		column = position.Column
+	}
+
+	for _, problem := range problems {
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("The description for `%s` contains %s; use plain prose.", subject, problem),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
@@ -0,0 +1,75 @@
+package rules
+
+import "testing"
+
+func TestDescriptionNoMarkdownHeaders(t *testing.T) {
+	rule := NewDescriptionNoMarkdownHeaders()
+
+	t.Run("should allow plain prose and hashtag comments", func(t *testing.T) {
+		schema := `
+		# Users of the platform
+		"""
+		A registered user. Use #1 priority for support tickets when score < 10 and age > 18.
+		"""
+		type User {
+			"The user's email address"
+			email: String
+			"Returns a List<String> of tags"
+			tags: [String!]
+			"The URL of the form https://example.com/<name>"
+			profileUrl: String
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "description-no-markdown-headers") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag markdown headers and HTML tags", func(t *testing.T) {
+		schema := `
+		"""
+		## Overview
+		A registered user.
+		"""
+		type User {
+			"The user's <b>primary</b> email address"
+			email: String
+		}
+
+		enum Status {
+			"""
+			# Active
+			"""
+			ACTIVE
+		}
+
+		type Query {
+			user("""
+			The id<br/>of the user
+			"""
+			id: ID): User
+			status: Status
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"The description for `User` contains a markdown header; use plain prose.",
+			"The description for `User.email` contains an HTML tag; use plain prose.",
+			"The description for `Status.ACTIVE` contains a markdown header; use plain prose.",
+			"The description for `Query.user(id:)` contains an HTML tag; use plain prose.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "description-no-markdown-headers") != 4 {
+			t.Errorf("Expected 4 errors, got %v", errors)
+		}
+	})
+}
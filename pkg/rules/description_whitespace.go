@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DescriptionWhitespace checks that descriptions have no trailing whitespace, leading tabs or carriage returns
+type DescriptionWhitespace struct{}
+
+// NewDescriptionWhitespace creates a new instance of the DescriptionWhitespace rule
+func NewDescriptionWhitespace() *DescriptionWhitespace {
+	return &DescriptionWhitespace{}
+}
+
+// Name returns the rule name
+func (r *DescriptionWhitespace) Name() string {
+	return "description-whitespace"
+}
+
+// Description returns what this rule checks
+func (r *DescriptionWhitespace) Description() string {
+	return "Descriptions should not contain trailing whitespace, leading tabs or carriage returns"
+}
+
+// Check validates the whitespace in every description
+func (r *DescriptionWhitespace) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	visitDescriptions(schema, func(description, subject string, position *ast.Position) {
+		line, column := 1, 1
+		if position != nil {
+			line = position.Line
+			column = position.Column
+		}
+
+		for _, problem := range r.findProblems(description) {
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("The description for `%s` has %s.", subject, problem),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	})
+
+	return errors
+}
+
+// findProblems returns each kind of whitespace problem in a description once
+func (r *DescriptionWhitespace) findProblems(description string) []string {
+	var problems []string
+
+	// Block strings normalize line endings, but escaped carriage returns survive in quoted strings
+	if strings.Contains(description, "\r") {
+		problems = append(problems, "a carriage return")
+	}
+
+	lines := strings.Split(strings.ReplaceAll(description, "\r", ""), "\n")
+	for _, line := range lines {
+		if strings.TrimRight(line, " \t") != line {
+			problems = append(problems, "trailing whitespace")
+			break
+		}
+	}
+	for _, line := range lines {
+		if strings.HasPrefix(line, "\t") {
+			problems = append(problems, "a leading tab")
+			break
+		}
+	}
+
+	return problems
+}
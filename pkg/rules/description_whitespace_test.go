@@ -0,0 +1,58 @@
+package rules
+
+import "testing"
+
+func TestDescriptionWhitespace(t *testing.T) {
+	rule := NewDescriptionWhitespace()
+
+	t.Run("should allow clean descriptions", func(t *testing.T) {
+		schema := `
+		"""
+		A registered user.
+		  Indented with spaces.
+		"""
+		type User {
+			"The user's id"
+			id: ID!
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "description-whitespace") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag trailing whitespace, leading tabs and carriage returns", func(t *testing.T) {
+		schema := "\"\"\"\nA registered user.  \n\t\tIndented with tabs.\n\"\"\"\n" + `
+		type User {
+			"The user's id "
+			id: ID!
+			"First line\r\nsecond line"
+			name: String
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"The description for `User` has trailing whitespace.",
+			"The description for `User` has a leading tab.",
+			"The description for `User.id` has trailing whitespace.",
+			"The description for `User.name` has a carriage return.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "description-whitespace") != 4 {
+			t.Errorf("Expected 4 errors, got %v", errors)
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DirectiveSelectionTypeValid checks that @key, @requires and @provides selections match the types they select from
+type DirectiveSelectionTypeValid struct{}
+
+// NewDirectiveSelectionTypeValid creates a new instance of the DirectiveSelectionTypeValid rule
+func NewDirectiveSelectionTypeValid() *DirectiveSelectionTypeValid {
+	return &DirectiveSelectionTypeValid{}
+}
+
+// Name returns the rule name
+func (r *DirectiveSelectionTypeValid) Name() string {
+	return "directive-selection-type-valid"
+}
+
+// Description returns what this rule checks
+func (r *DirectiveSelectionTypeValid) Description() string {
+	return "Field selections in @key, @requires and @provides must select sub-fields only on composite types and on every composite type, and nested @key fields must exist; invalid selections, top-level @key fields and @requires and @provides fields are left to their own rules"
+}
+
+// Check validates the nested field selections of federation directives
+func (r *DirectiveSelectionTypeValid) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		// @key selects fields of the type it is applied to
+		for _, directive := range def.Directives.ForNames("key") {
+			errors = append(errors, r.checkDirective(schema, source, directive, def.Name, def)...)
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			owner := def.Name + "." + field.Name
+
+			// @requires selects sibling fields of the parent type
+			for _, directive := range field.Directives.ForNames("requires") {
+				errors = append(errors, r.checkDirective(schema, source, directive, owner, def)...)
+			}
+
+			// @provides selects fields of the returned type
+			returnType := schema.Types[field.Type.Name()]
+			if returnType == nil {
+				continue
+			}
+			for _, directive := range field.Directives.ForNames("provides") {
+				errors = append(errors, r.checkDirective(schema, source, directive, owner, returnType)...)
+			}
+		}
+	}
+
+	return errors
+}
+
+// checkDirective parses the directive's fields argument and validates it against the selected type
+func (r *DirectiveSelectionTypeValid) checkDirective(schema *ast.Schema, source *ast.Source, directive *ast.Directive, owner string, def *ast.Definition) []types.LintError {
+	var errors []types.LintError
+
+	fieldsArg := directive.Arguments.ForName("fields")
+	if fieldsArg == nil || fieldsArg.Value == nil || fieldsArg.Value.Kind != ast.StringValue {
+		return errors
+	}
+	fields := strings.Join(strings.Fields(fieldsArg.Value.Raw), " ")
+
+	line, column := 1, 1
+	if directive.Position != nil {
+		line = directive.Position.Line
+		column = directive.Position.Column
+	}
+
+	// Invalid selections are reported by key-directive-lint and the @requires and @provides rules, as are
+	// missing fields other than the nested fields of @key
+	selectionSet, err := parseFieldSelection(def.Name, fields)
+	if err != nil {
+		return errors
+	}
+	checkNestedExistence := directive.Name == "key"
+
+	for _, problem := range r.validateSelection(schema, def, selectionSet, checkNestedExistence) {
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("@%s on `%s` selects `%s` but %s.", directive.Name, owner, fields, problem),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// validateSelection checks a selection set against a type at every level and describes each problem found.
+// Fields that don't exist are only described below the top level, and only when checkNestedExistence is set.
+func (r *DirectiveSelectionTypeValid) validateSelection(schema *ast.Schema, def *ast.Definition, selectionSet ast.SelectionSet, checkNestedExistence bool) []string {
+	var problems []string
+
+	topLevel := make(map[*ast.Field]bool)
+	for _, sel := range selectionSet {
+		if field, ok := sel.(*ast.Field); ok {
+			topLevel[field] = true
+		}
+	}
+
+	walkFieldSelection(schema, def, selectionSet, func(owner *ast.Definition, sel *ast.Field, field *ast.FieldDefinition) {
+		if field == nil {
+			if checkNestedExistence && !topLevel[sel] {
+				problems = append(problems, fmt.Sprintf("`%s` does not exist on `%s`", sel.Name, owner.Name))
+			}
+			return
+		}
+
+		fieldType := schema.Types[field.Type.Name()]
+		if fieldType == nil {
+			return
+		}
+
+		isLeaf := fieldType.Kind == ast.Scalar || fieldType.Kind == ast.Enum
+		switch {
+		case isLeaf && len(sel.SelectionSet) > 0:
+			kind := "a scalar"
+			if fieldType.Kind == ast.Enum {
+				kind = "an enum"
+			}
+			problems = append(problems, fmt.Sprintf("`%s` is %s and can't have a sub-selection", sel.Name, kind))
+		case !isLeaf && len(sel.SelectionSet) == 0:
+			problems = append(problems, fmt.Sprintf("`%s` returns `%s` and needs a sub-selection", sel.Name, fieldType.Name))
+		}
+	}, func(name string) {
+		problems = append(problems, fmt.Sprintf("type `%s` does not exist", name))
+	})
+
+	return problems
+}
@@ -0,0 +1,164 @@
+package rules
+
+import "testing"
+
+const federationDirectives = `
+	directive @key(fields: String!) on OBJECT | INTERFACE
+	directive @requires(fields: String!) on FIELD_DEFINITION
+	directive @provides(fields: String!) on FIELD_DEFINITION
+	directive @external on FIELD_DEFINITION
+`
+
+func TestDirectiveSelectionTypeValid(t *testing.T) {
+	rule := NewDirectiveSelectionTypeValid()
+
+	t.Run("should flag sub-selections on scalar fields", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Address {
+			zip: String
+			country: Country
+		}
+
+		type Country {
+			code: String
+		}
+
+		type Order @key(fields: "id") {
+			id: ID!
+			address: Address @external
+			tax: Float @requires(fields: "address { zip { bad } }")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "directive-selection-type-valid") != 1 {
+			t.Errorf("Expected 1 error, got %d", countRuleErrors(errors, "directive-selection-type-valid"))
+		}
+
+		expectedMessage := "@requires on `Order.tax` selects `address { zip { bad } }` but `zip` is a scalar and can't have a sub-selection."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should flag missing nested @key fields and missing sub-selections", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Country {
+			code: String
+		}
+
+		type Address {
+			zip: String
+			country: Country
+		}
+
+		type Order @key(fields: "address") {
+			id: ID!
+			address: Address
+		}
+
+		type Shipment @key(fields: "address { country { name } }") {
+			id: ID!
+			address: Address
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "directive-selection-type-valid") != 2 {
+			t.Errorf("Expected 2 errors, got %d", countRuleErrors(errors, "directive-selection-type-valid"))
+		}
+
+		expectedMessages := []string{
+			"@key on `Order` selects `address` but `address` returns `Address` and needs a sub-selection.",
+			"@key on `Shipment` selects `address { country { name } }` but `name` does not exist on `Country`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should leave missing @requires and @provides fields to their own rules", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Country {
+			code: String
+		}
+
+		type Address {
+			zip: String
+			country: Country
+		}
+
+		type Order @key(fields: "id") {
+			id: ID!
+			address: Address @external
+			tax: Float @requires(fields: "address { country { name } }")
+			fee: Float @requires(fields: "address {")
+		}
+
+		type Query {
+			order: Order @provides(fields: "address { street }")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "directive-selection-type-valid") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+
+		requiresErrors := runRule(t, NewFederationRequiresFieldsExist(), schema)
+		if countRuleErrors(requiresErrors, "federation-requires-fields-exist") != 2 {
+			t.Errorf("Expected federation-requires-fields-exist to report 2 errors, got %v", requiresErrors)
+		}
+		providesErrors := runRule(t, NewFederationProvidesFieldsExist(), schema)
+		if countRuleErrors(providesErrors, "federation-provides-fields-exist") != 1 {
+			t.Errorf("Expected federation-provides-fields-exist to report 1 error, got %v", providesErrors)
+		}
+	})
+
+	t.Run("should leave top-level @key fields and invalid selections to key-directive-lint", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Product @key(fields: "sku missing") {
+			sku: ID!
+		}
+
+		type Variant @key(fields: "id {") {
+			id: ID!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "directive-selection-type-valid") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+
+		keyErrors := runRule(t, NewKeyDirectivesLint(), schema)
+		if countRuleErrors(keyErrors, "key-directive-lint") != 2 {
+			t.Errorf("Expected key-directive-lint to report 2 errors, got %v", keyErrors)
+		}
+	})
+
+	t.Run("should pass valid nested selections", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Country {
+			code: String
+		}
+
+		type Address {
+			zip: String
+			country: Country
+		}
+
+		type Order @key(fields: "id address { zip }") {
+			id: ID!
+			address: Address @external
+			tax: Float @requires(fields: "address { zip country { code } }")
+		}
+
+		type Query {
+			order: Order @provides(fields: "address { country { code } }")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "directive-selection-type-valid") > 0 {
+			t.Errorf("Expected no errors for valid selections, got %d", countRuleErrors(errors, "directive-selection-type-valid"))
+		}
+	})
+}
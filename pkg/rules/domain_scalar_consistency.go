@@ -0,0 +1,162 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// ScalarConcept maps a domain concept, recognized by field name, to the scalar that should represent it
+type ScalarConcept struct {
+	// Concept is the human-readable concept name used in messages (e.g. "time")
+	Concept string
+	// Scalar is the scalar type fields of this concept must use (e.g. "DateTime")
+	Scalar string
+	// Pattern matches the names of fields that belong to this concept
+	Pattern *regexp.Regexp
+	// Words, if set, are matched instead of Pattern: a field belongs to the concept if any camelCase word
+	// of its name is one of them, ignoring case (e.g. `amountDue` and `unitPrice`)
+	Words []string
+}
+
+// MoneyConcept recognizes monetary fields (total, amountDue, unitPrice, ...).
+// It is enforced by no-float-money rather than by default here.
+var MoneyConcept = ScalarConcept{
+	Concept: "money",
+	Scalar:  "Money",
+	Words:   []string{"price", "cost", "fee", "amount", "balance", "salary", "subtotal", "total"},
+}
+
+// TimeConcept recognizes fields that hold a point in time (createdAt, startTime, timestamp, ...).
+// It is enforced by consistent-timestamp-scalar rather than by default here.
+var TimeConcept = ScalarConcept{
+	Concept: "time",
+	Scalar:  "DateTime",
+	Pattern: regexp.MustCompile(`^(timestamp|time|datetime)$|[a-z](At|Time|Timestamp)$`),
+}
+
+// DefaultScalarConcepts are the concept mappings used by NewDomainScalarConsistency when none are configured.
+// Money and time are left out so that no-float-money and consistent-timestamp-scalar are the only default
+// rules reporting them.
+var DefaultScalarConcepts = []ScalarConcept{
+	{
+		Concept: "url",
+		Scalar:  "URL",
+		Pattern: regexp.MustCompile(`^(url|uri|href)$|[a-z](Url|URL|Uri|URI|Href)$`),
+	},
+}
+
+// DomainScalarConsistency checks that fields representing a domain concept use the concept's scalar
+type DomainScalarConsistency struct {
+	concepts []ScalarConcept
+}
+
+// NewDomainScalarConsistency creates a new instance of the DomainScalarConsistency rule.
+// If concepts is empty, DefaultScalarConcepts is used.
+func NewDomainScalarConsistency(concepts []ScalarConcept) *DomainScalarConsistency {
+	if len(concepts) == 0 {
+		concepts = DefaultScalarConcepts
+	}
+	return &DomainScalarConsistency{concepts: concepts}
+}
+
+// Name returns the rule name
+func (r *DomainScalarConsistency) Name() string {
+	return "domain-scalar-consistency"
+}
+
+// Description returns what this rule checks
+func (r *DomainScalarConsistency) Description() string {
+	return "Fields representing a domain concept (url by default) should consistently use the scalar mapped to that concept"
+}
+
+// Check validates that concept fields use their mapped scalar
+func (r *DomainScalarConsistency) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	return checkScalarConcepts(schema, source, r.Name(), r.concepts)
+}
+
+// checkScalarConcepts flags scalar-typed fields whose names match a concept but which use a different scalar
+func checkScalarConcepts(schema *ast.Schema, source *ast.Source, ruleName string, concepts []ScalarConcept) []types.LintError {
+	var errors []types.LintError
+
+	visitConceptFields(schema, concepts, func(def *ast.Definition, field *ast.FieldDefinition, concept ScalarConcept) {
+		typeName := field.Type.Name()
+		if typeName == concept.Scalar {
+			return
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Field `%s.%s` is a %s concept but uses `%s` instead of `%s`.", def.Name, field.Name, concept.Concept, typeName, concept.Scalar),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: ruleName,
+		})
+	})
+
+	return errors
+}
+
+// matches reports whether a field name belongs to the concept
+func (c ScalarConcept) matches(name string) bool {
+	if len(c.Words) == 0 {
+		return c.Pattern != nil && c.Pattern.MatchString(name)
+	}
+	for _, word := range splitCamelCase(name) {
+		for _, conceptWord := range c.Words {
+			if strings.EqualFold(word, conceptWord) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// visitConceptFields calls visit for every scalar-typed field whose name matches a concept, with the first
+// matching concept. It is shared by rules that enforce a single concept so they can be enabled separately.
+// Pagination helpers are skipped, since fields like `Connection.total` count items rather than hold money.
+func visitConceptFields(schema *ast.Schema, concepts []ScalarConcept, visit func(def *ast.Definition, field *ast.FieldDefinition, concept ScalarConcept)) {
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface && def.Kind != ast.InputObject {
+			continue
+		}
+		if isPaginationHelperType(def.Name) {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			// Only scalar fields are compared; object-typed fields model the concept differently
+			typeDef := schema.Types[field.Type.Name()]
+			if typeDef == nil || typeDef.Kind != ast.Scalar {
+				continue
+			}
+
+			for _, concept := range concepts {
+				if concept.matches(field.Name) {
+					visit(def, field, concept)
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestDomainScalarConsistency(t *testing.T) {
+	rule := NewDomainScalarConsistency(nil)
+
+	t.Run("should leave money and time to their own rules by default", func(t *testing.T) {
+		schema := `
+		type Invoice {
+			paidAt: String
+			grandTotal: Float!
+			receiptUrl: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Field `Invoice.receiptUrl` is a url concept but uses `String` instead of `URL`."
+		if countRuleErrors(errors, "domain-scalar-consistency") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should flag concept fields using the wrong scalar", func(t *testing.T) {
+		rule := NewDomainScalarConsistency(append([]ScalarConcept{MoneyConcept, TimeConcept}, DefaultScalarConcepts...))
+		schema := `
+		scalar DateTime
+		scalar Money
+		scalar URL
+
+		type Invoice {
+			paidAt: String
+			grandTotal: Float!
+			receiptUrl: String
+			createdAt: DateTime
+		}
+
+		input InvoiceInput {
+			dueAt: Int
+			amount: Money
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "domain-scalar-consistency") != 4 {
+			t.Errorf("Expected 4 errors for concept fields with wrong scalars, got %d", countRuleErrors(errors, "domain-scalar-consistency"))
+		}
+
+		expectedMessages := []string{
+			"Field `Invoice.paidAt` is a time concept but uses `String` instead of `DateTime`.",
+			"Field `Invoice.grandTotal` is a money concept but uses `Float` instead of `Money`.",
+			"Field `Invoice.receiptUrl` is a url concept but uses `String` instead of `URL`.",
+			"Field `InvoiceInput.dueAt` is a time concept but uses `Int` instead of `DateTime`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should ignore unrelated and object-typed fields", func(t *testing.T) {
+		schema := `
+		type Price {
+			value: Int
+		}
+
+		type Product {
+			format: String
+			chat: String
+			totalCount: Int
+			total: Int
+			price: Price
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "domain-scalar-consistency") > 0 {
+			t.Errorf("Expected no errors for unrelated fields, got %d", countRuleErrors(errors, "domain-scalar-consistency"))
+		}
+	})
+
+	t.Run("should skip counts on pagination helpers", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type Order {
+			id: ID!
+		}
+
+		type OrderEdge {
+			node: Order!
+			cursor: String!
+		}
+
+		type OrderConnection {
+			edges: [OrderEdge!]!
+			pageInfo: PageInfo!
+			total: Int!
+			pageTotal: Int!
+		}
+
+		type Query {
+			orders: OrderConnection!
+		}
+		`
+		errors := runRule(t, NewDomainScalarConsistency([]ScalarConcept{MoneyConcept}), schema)
+		if countRuleErrors(errors, "domain-scalar-consistency") > 0 {
+			t.Errorf("Expected no errors for connection counts, got %v", errors)
+		}
+	})
+
+	t.Run("should use configured concept mappings", func(t *testing.T) {
+		schema := `
+		scalar Email
+
+		type User {
+			email: String
+			contactEmail: Email
+			createdAt: String
+		}
+		`
+		concepts := []ScalarConcept{
+			{Concept: "email", Scalar: "Email", Pattern: regexp.MustCompile(`(?i)email$`)},
+		}
+		errors := runRule(t, NewDomainScalarConsistency(concepts), schema)
+		if countRuleErrors(errors, "domain-scalar-consistency") != 1 {
+			t.Errorf("Expected 1 error with custom concepts, got %d", countRuleErrors(errors, "domain-scalar-consistency"))
+		}
+		if !containsError(errors, "Field `User.email` is a email concept but uses `String` instead of `Email`.") {
+			t.Error("Expected User.email to be flagged")
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// EntityIdNonNull checks that the id field of entity types is non-null
+type EntityIdNonNull struct{}
+
+// NewEntityIdNonNull creates a new instance of the EntityIdNonNull rule
+func NewEntityIdNonNull() *EntityIdNonNull {
+	return &EntityIdNonNull{}
+}
+
+// Name returns the rule name
+func (r *EntityIdNonNull) Name() string {
+	return "entity-id-non-null"
+}
+
+// Description returns what this rule checks
+func (r *EntityIdNonNull) Description() string {
+	return "The `id` field of entity types must be non-null; companion to fields-nullable-except-id, which keeps every other field nullable"
+}
+
+// Check validates that entity types declare a non-null id field
+func (r *EntityIdNonNull) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object || isPaginationHelperType(def.Name) {
+			continue
+		}
+		if def == schema.Query || def == schema.Mutation || def == schema.Subscription {
+			continue
+		}
+
+		idField := def.Fields.ForName("id")
+		if idField == nil || idField.Type.NonNull {
+			continue
+		}
+
+		line, column := 1, 1
+		if idField.Position != nil {
+			line = idField.Position.Line
+			column = idField.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Type `%s` has a nullable `id` field; entity identifiers must be non-null.", def.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
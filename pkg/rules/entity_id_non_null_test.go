@@ -0,0 +1,54 @@
+package rules
+
+import "testing"
+
+func TestEntityIdNonNull(t *testing.T) {
+	rule := NewEntityIdNonNull()
+
+	t.Run("should allow non-null ids and skip helper types", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			name: String
+		}
+
+		type UserEdge {
+			id: ID
+			node: User
+		}
+
+		type Settings {
+			theme: String
+		}
+
+		type Query {
+			id: ID
+			user: User
+			edge: UserEdge
+			settings: Settings
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "entity-id-non-null") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag nullable ids", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID
+			name: String
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Type `User` has a nullable `id` field; entity identifiers must be non-null."
+		if countRuleErrors(errors, "entity-id-non-null") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
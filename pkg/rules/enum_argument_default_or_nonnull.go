@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// EnumArgumentDefaultOrNonNull checks that enum arguments are non-null or have a default value
+type EnumArgumentDefaultOrNonNull struct{}
+
+// NewEnumArgumentDefaultOrNonNull creates a new instance of the EnumArgumentDefaultOrNonNull rule
+func NewEnumArgumentDefaultOrNonNull() *EnumArgumentDefaultOrNonNull {
+	return &EnumArgumentDefaultOrNonNull{}
+}
+
+// Name returns the rule name
+func (r *EnumArgumentDefaultOrNonNull) Name() string {
+	return "enum-argument-default-or-nonnull"
+}
+
+// Description returns what this rule checks
+func (r *EnumArgumentDefaultOrNonNull) Description() string {
+	return "Enum arguments should either be non-null or provide a default value (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *EnumArgumentDefaultOrNonNull) OptIn() bool {
+	return true
+}
+
+// Check validates that nullable enum arguments have a default value
+func (r *EnumArgumentDefaultOrNonNull) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			for _, arg := range field.Arguments {
+				// Only plain enum arguments; lists of enums are usually optional filters
+				if arg.Type.NamedType == "" || arg.Type.NonNull || arg.DefaultValue != nil {
+					continue
+				}
+				argType := schema.Types[arg.Type.NamedType]
+				if argType == nil || argType.Kind != ast.Enum {
+					continue
+				}
+
+				line, column := 1, 1
+				if arg.Position != nil {
+					line = arg.Position.Line
+					column = arg.Position.Column
+				}
+
+				errors = append(errors, types.LintError{
+					Message: fmt.Sprintf("Enum argument `%s` on `%s.%s` should either be non-null or provide a default value.", arg.Name, def.Name, field.Name),
+					Location: types.Location{
+						Line:   line,
+						Column: column,
+						File:   source.Name,
+					},
+					Rule: r.Name(),
+				})
+			}
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,61 @@
+package rules
+
+import "testing"
+
+func TestEnumArgumentDefaultOrNonNull(t *testing.T) {
+	rule := NewEnumArgumentDefaultOrNonNull()
+
+	schema := `
+	enum UserSort {
+		NAME
+		CREATED_AT
+	}
+
+	type User {
+		id: ID!
+	}
+
+	interface Searchable {
+		search(sort: UserSort): [User!]!
+	}
+
+	type Query {
+		users(sort: UserSort): [User!]!
+		sortedUsers(sort: UserSort = NAME): [User!]!
+		requiredSortUsers(sort: UserSort!): [User!]!
+		filteredUsers(sorts: [UserSort!], name: String): [User!]!
+	}
+	`
+
+	t.Run("should flag nullable enum arguments without a default", func(t *testing.T) {
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "enum-argument-default-or-nonnull") != 2 {
+			t.Errorf("Expected 2 errors for nullable enum arguments, got %d", countRuleErrors(errors, "enum-argument-default-or-nonnull"))
+		}
+
+		expectedMessages := []string{
+			"Enum argument `sort` on `Query.users` should either be non-null or provide a default value.",
+			"Enum argument `sort` on `Searchable.search` should either be non-null or provide a default value.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should pass nullable arguments with a default and non-null arguments", func(t *testing.T) {
+		errors := runRule(t, rule, schema)
+		for _, field := range []string{"sortedUsers", "requiredSortUsers", "filteredUsers"} {
+			if containsError(errors, "Enum argument `sort` on `Query."+field+"` should either be non-null or provide a default value.") {
+				t.Errorf("Expected Query.%s to pass", field)
+			}
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected enum-argument-default-or-nonnull to be opt-in")
+		}
+	})
+}
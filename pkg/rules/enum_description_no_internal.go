@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultEnumInternalPatterns are the forbidden description patterns used by NewEnumDescriptionNoInternal
+// when none are configured: internal markers, deprecation notes that belong in @deprecated, and ticket IDs.
+var DefaultEnumInternalPatterns = []string{
+	`(?i)\binternal\b`,
+	`(?i)\bdeprecated\b`,
+	`(?i)\bdo not use\b`,
+	`\b[A-Z][A-Z0-9]+-\d{2,}\b`,
+}
+
+// ticketIDPattern matches a whole ticket ID such as `JIRA-123`
+var ticketIDPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]+-\d{2,}$`)
+
+// standardIDPattern matches standard identifiers that look like ticket IDs, such as `ISO-8601` and `UTF-8`
+var standardIDPattern = regexp.MustCompile(`^(ISO|UTF|RFC)-\d+$`)
+
+// EnumDescriptionNoInternal checks that enum value descriptions do not reference internal details
+type EnumDescriptionNoInternal struct {
+	patterns []*regexp.Regexp
+}
+
+// NewEnumDescriptionNoInternal creates a new instance of the EnumDescriptionNoInternal rule.
+// Each pattern is a regular expression that enum value descriptions must not match; plain substrings
+// work as-is. If patterns is empty, DefaultEnumInternalPatterns is used. It panics if a pattern is not
+// a valid regular expression.
+func NewEnumDescriptionNoInternal(patterns []string) *EnumDescriptionNoInternal {
+	if len(patterns) == 0 {
+		patterns = DefaultEnumInternalPatterns
+	}
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		compiled = append(compiled, regexp.MustCompile(pattern))
+	}
+	return &EnumDescriptionNoInternal{patterns: compiled}
+}
+
+// Name returns the rule name
+func (r *EnumDescriptionNoInternal) Name() string {
+	return "enum-description-no-internal"
+}
+
+// Description returns what this rule checks
+func (r *EnumDescriptionNoInternal) Description() string {
+	return "Enum value descriptions should be user-facing and not mention internal details, deprecation notes or ticket IDs (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *EnumDescriptionNoInternal) OptIn() bool {
+	return true
+}
+
+// Check validates that enum value descriptions contain no forbidden patterns
+func (r *EnumDescriptionNoInternal) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") || def.Kind != ast.Enum {
+			continue
+		}
+
+		for _, value := range def.EnumValues {
+			match := r.findForbidden(value.Description)
+			if match == "" {
+				continue
+			}
+
+			line, column := 1, 1
+			if value.Position != nil {
+				line = value.Position.Line
+				column = value.Position.Column
+			}
+
+			reference := fmt.Sprintf("`%s`", match)
+			if ticketIDPattern.MatchString(match) {
+				reference = "an internal ticket " + reference
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Description for `%s.%s` references %s; keep docs user-facing.", def.Name, value.Name, reference),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// findForbidden returns the first text in a description matched by a forbidden pattern, or "" if none match.
+// Standard identifiers are never forbidden.
+func (r *EnumDescriptionNoInternal) findForbidden(description string) string {
+	if description == "" {
+		return ""
+	}
+	for _, pattern := range r.patterns {
+		for _, match := range pattern.FindAllString(description, -1) {
+			if match != "" && !standardIDPattern.MatchString(match) {
+				return match
+			}
+		}
+	}
+	return ""
+}
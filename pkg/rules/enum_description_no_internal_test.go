@@ -0,0 +1,91 @@
+package rules
+
+import "testing"
+
+func TestEnumDescriptionNoInternal(t *testing.T) {
+	rule := NewEnumDescriptionNoInternal(nil)
+
+	t.Run("should allow user-facing descriptions", func(t *testing.T) {
+		schema := `
+		enum Status {
+			"The order is being prepared."
+			ACTIVE
+			"The order was archived by its owner."
+			ARCHIVED
+			"Dates are in ISO-8601 format and text is UTF-8, as in RFC-3339."
+			SCHEDULED
+			"Shipped by carrier X-1."
+			SHIPPED
+			PENDING
+		}
+
+		type Query {
+			status: Status
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "enum-description-no-internal") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag ticket IDs and internal markers", func(t *testing.T) {
+		schema := `
+		enum Status {
+			"Archived orders, see JIRA-123."
+			ARCHIVED
+			"Internal use only."
+			LEGACY
+			"DO NOT USE"
+			BROKEN
+			"Dates are in ISO-8601 format, tracked in PAY-42."
+			DATED
+		}
+
+		type Query {
+			status: Status
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Description for `Status.ARCHIVED` references an internal ticket `JIRA-123`; keep docs user-facing.",
+			"Description for `Status.LEGACY` references `Internal`; keep docs user-facing.",
+			"Description for `Status.BROKEN` references `DO NOT USE`; keep docs user-facing.",
+			"Description for `Status.DATED` references an internal ticket `PAY-42`; keep docs user-facing.",
+		}
+		if countRuleErrors(errors, "enum-description-no-internal") != len(expectedMessages) {
+			t.Errorf("Expected %d errors, got %v", len(expectedMessages), errors)
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+	})
+
+	t.Run("should use configured patterns", func(t *testing.T) {
+		schema := `
+		enum Status {
+			"Backed by the orders_v2 table."
+			ACTIVE
+			"Internal use only."
+			LEGACY
+		}
+
+		type Query {
+			status: Status
+		}
+		`
+		errors := runRule(t, NewEnumDescriptionNoInternal([]string{`\w+_v\d+`}), schema)
+		expectedMessage := "Description for `Status.ACTIVE` references `orders_v2`; keep docs user-facing."
+		if countRuleErrors(errors, "enum-description-no-internal") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// EnumFieldNameRelation checks that enum-typed fields share a word with the enum they use
+type EnumFieldNameRelation struct{}
+
+// NewEnumFieldNameRelation creates a new instance of the EnumFieldNameRelation rule
+func NewEnumFieldNameRelation() *EnumFieldNameRelation {
+	return &EnumFieldNameRelation{}
+}
+
+// Name returns the rule name
+func (r *EnumFieldNameRelation) Name() string {
+	return "enum-field-name-relation"
+}
+
+// Description returns what this rule checks
+func (r *EnumFieldNameRelation) Description() string {
+	return "Enum-typed fields should share a camelCase word with the enum they use, e.g. `User.status: UserStatus` (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *EnumFieldNameRelation) OptIn() bool {
+	return true
+}
+
+// Check validates that enum-typed field names relate to their enum names
+func (r *EnumFieldNameRelation) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface && def.Kind != ast.InputObject {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+			enumDef := schema.Types[field.Type.Name()]
+			if enumDef == nil || enumDef.Kind != ast.Enum || enumDef.BuiltIn {
+				continue
+			}
+			if r.sharesWord(field.Name, enumDef.Name) {
+				continue
+			}
+
+			suggestion := r.fieldNameFor(enumDef.Name)
+			if isListType(field.Type) {
+				suggestion = pluralizeName(suggestion)
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s.%s` uses enum `%s`, but the names are unrelated; consider `%s`.", def.Name, field.Name, enumDef.Name, suggestion),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// sharesWord checks if two names have a camelCase word in common, treating plurals as the same word
+func (r *EnumFieldNameRelation) sharesWord(fieldName, enumName string) bool {
+	for _, fieldWord := range splitCamelCase(fieldName) {
+		fieldWord = strings.ToLower(fieldWord)
+		for _, enumWord := range splitCamelCase(enumName) {
+			enumWord = strings.ToLower(enumWord)
+			if fieldWord == enumWord || pluralizeName(enumWord) == fieldWord || pluralizeName(fieldWord) == enumWord {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fieldNameFor converts an enum name to a camelCase field name, e.g. `HTTPMethod` becomes `httpMethod`
+func (r *EnumFieldNameRelation) fieldNameFor(enumName string) string {
+	words := splitCamelCase(enumName)
+	if len(words) == 0 {
+		return enumName
+	}
+	return strings.ToLower(words[0]) + strings.Join(words[1:], "")
+}
@@ -0,0 +1,77 @@
+package rules
+
+import "testing"
+
+func TestEnumFieldNameRelation(t *testing.T) {
+	rule := NewEnumFieldNameRelation()
+
+	t.Run("should allow fields sharing a word with their enum", func(t *testing.T) {
+		schema := `
+		enum UserStatus {
+			ACTIVE
+		}
+
+		enum Role {
+			ADMIN
+		}
+
+		enum HTTPMethod {
+			GET
+		}
+
+		type User {
+			status: UserStatus
+			roles: [Role!]!
+			method: HTTPMethod
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "enum-field-name-relation") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag fields unrelated to their enum", func(t *testing.T) {
+		schema := `
+		enum PaymentMethod {
+			CARD
+		}
+
+		enum HTTPVerb {
+			GET
+		}
+
+		type User {
+			kind: PaymentMethod
+			allowed: [HTTPVerb!]
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Field `User.kind` uses enum `PaymentMethod`, but the names are unrelated; consider `paymentMethod`.",
+			"Field `User.allowed` uses enum `HTTPVerb`, but the names are unrelated; consider `httpVerbs`.",
+		}
+		if countRuleErrors(errors, "enum-field-name-relation") != len(expectedMessages) {
+			t.Errorf("Expected %d errors, got %v", len(expectedMessages), errors)
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// ErrorTypeFieldUsage checks that @error types are only used as response union members, never as field types
+type ErrorTypeFieldUsage struct {
+	mutationLint *MutationLint
+}
+
+// NewErrorTypeFieldUsage creates a new instance of the ErrorTypeFieldUsage rule
+func NewErrorTypeFieldUsage() *ErrorTypeFieldUsage {
+	return &ErrorTypeFieldUsage{mutationLint: NewMutationLint()}
+}
+
+// Name returns the rule name
+func (r *ErrorTypeFieldUsage) Name() string {
+	return "error-type-field-usage"
+}
+
+// Description returns what this rule checks
+func (r *ErrorTypeFieldUsage) Description() string {
+	return "Types marked @error should only appear inside response unions, not as the return type of regular fields"
+}
+
+// Check validates that no regular field returns an @error type
+func (r *ErrorTypeFieldUsage) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	errorTypes := make(map[string]bool)
+	for _, name := range r.mutationLint.findErrorTypes(schema) {
+		errorTypes[name] = true
+	}
+	if len(errorTypes) == 0 {
+		return errors
+	}
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+		// Fields of a @responseUnion object type are the members of a virtual union
+		if r.mutationLint.hasResponseUnionDirective(def) {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			typeName := field.Type.Name()
+			if !errorTypes[typeName] {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s.%s` returns the @error type `%s`; error types should only appear inside response unions.",
+					def.Name, field.Name, typeName),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,74 @@
+package rules
+
+import "testing"
+
+func TestErrorTypeFieldUsage(t *testing.T) {
+	rule := NewErrorTypeFieldUsage()
+
+	t.Run("should allow error types as union members", func(t *testing.T) {
+		schema := `
+		directive @responseUnion on UNION | OBJECT
+		directive @error on OBJECT
+
+		union MobileRiders @responseUnion = MockMobileRider | RiderNotFound
+
+		type RiderNotFound @error {
+			code: String!
+			message: String!
+		}
+
+		type MockMobileRider {
+			id: ID!
+			name: String!
+		}
+
+		type RiderResult @responseUnion {
+			rider: MockMobileRider
+			notFound: RiderNotFound
+		}
+
+		type Mutation {
+			resolveMobileRiders(id: ID!): MobileRiders!
+			resolveRider(id: ID!): RiderResult!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "error-type-field-usage") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag fields returning error types", func(t *testing.T) {
+		schema := `
+		directive @error on OBJECT
+
+		type ValidationError @error {
+			code: String!
+			message: String!
+		}
+
+		type User {
+			id: ID!
+			lastError: ValidationError
+			errors: [ValidationError!]
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Field `User.lastError` returns the @error type `ValidationError`; error types should only appear inside response unions.",
+			"Field `User.errors` returns the @error type `ValidationError`; error types should only appear inside response unions.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "error-type-field-usage") != 2 {
+			t.Errorf("Expected 2 errors, got %v", errors)
+		}
+	})
+}
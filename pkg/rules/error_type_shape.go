@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// errorTypeFields are the fields every @error type must declare as non-null strings
+var errorTypeFields = []string{"code", "message"}
+
+// ErrorTypeShape checks that @error types carry a machine-readable code and a human-readable message
+type ErrorTypeShape struct {
+	mutationLint *MutationLint
+}
+
+// NewErrorTypeShape creates a new instance of the ErrorTypeShape rule
+func NewErrorTypeShape() *ErrorTypeShape {
+	return &ErrorTypeShape{mutationLint: NewMutationLint()}
+}
+
+// Name returns the rule name
+func (r *ErrorTypeShape) Name() string {
+	return "error-type-shape"
+}
+
+// Description returns what this rule checks
+func (r *ErrorTypeShape) Description() string {
+	return "Types marked @error must contain non-null `code: String!` and `message: String!` fields"
+}
+
+// Check validates that @error types have `code` and `message` fields
+func (r *ErrorTypeShape) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if !r.mutationLint.hasErrorDirective(def) {
+			continue
+		}
+
+		for _, fieldName := range errorTypeFields {
+			field := def.Fields.ForName(fieldName)
+			if field != nil && field.Type.NonNull && field.Type.NamedType == "String" {
+				continue
+			}
+
+			// Point at the offending field, or at the type when the field is missing
+			position := def.Position
+			if field != nil && field.Position != nil {
+				position = field.Position
+			}
+
+			line, column := 1, 1
+			if position != nil {
+				line = position.Line
+				column = position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Error type `%s` must contain a non-null `%s: String!` field.", def.Name, fieldName),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,97 @@
+package rules
+
+import "testing"
+
+func TestErrorTypeShape(t *testing.T) {
+	rule := NewErrorTypeShape()
+
+	t.Run("should allow error types with code and message", func(t *testing.T) {
+		schema := `
+		directive @responseUnion on UNION
+		directive @error on OBJECT
+
+		union MobileRiders @responseUnion = MockMobileRider | RiderNotFound
+
+		type RiderNotFound @error {
+			code: String!
+			message: String!
+		}
+
+		type MockMobileRider {
+			id: ID!
+			name: String!
+		}
+
+		type Mutation {
+			resolveMobileRiders(id: ID!): MobileRiders!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "error-type-shape") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag error types missing code", func(t *testing.T) {
+		schema := `
+		directive @responseUnion on UNION
+		directive @error on OBJECT
+
+		union MobileRiders @responseUnion = MockMobileRider | RiderNotFound
+
+		type RiderNotFound @error {
+			message: String!
+		}
+
+		type MockMobileRider {
+			id: ID!
+			name: String!
+		}
+
+		type Mutation {
+			resolveMobileRiders(id: ID!): MobileRiders!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Error type `RiderNotFound` must contain a non-null `code: String!` field."
+		if countRuleErrors(errors, "error-type-shape") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should flag fields with the wrong type or nullability", func(t *testing.T) {
+		schema := `
+		directive @responseUnion on UNION
+		directive @error on OBJECT
+
+		union MobileRiders @responseUnion = MockMobileRider | RiderNotFound
+
+		type RiderNotFound @error {
+			code: Int!
+			message: String
+		}
+
+		type MockMobileRider {
+			id: ID!
+			name: String!
+		}
+
+		type Mutation {
+			resolveMobileRiders(id: ID!): MobileRiders!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Error type `RiderNotFound` must contain a non-null `code: String!` field.",
+			"Error type `RiderNotFound` must contain a non-null `message: String!` field.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "error-type-shape") != 2 {
+			t.Errorf("Expected 2 errors, got %v", errors)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// FederationExternalUnused checks that @external fields are referenced by a federation field selection
+type FederationExternalUnused struct{}
+
+// NewFederationExternalUnused creates a new instance of the FederationExternalUnused rule
+func NewFederationExternalUnused() *FederationExternalUnused {
+	return &FederationExternalUnused{}
+}
+
+// Name returns the rule name
+func (r *FederationExternalUnused) Name() string {
+	return "federation-external-unused"
+}
+
+// Description returns what this rule checks
+func (r *FederationExternalUnused) Description() string {
+	return "Fields marked @external must be referenced by a @key or @requires selection, otherwise they are dead"
+}
+
+// Check validates that every @external field is referenced
+func (r *FederationExternalUnused) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	referenced := r.collectReferencedFields(schema)
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			if field.Directives.ForName("external") == nil || referenced[def.Name+"."+field.Name] {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s.%s` is marked @external but is not referenced by any @key or @requires.", def.Name, field.Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// collectReferencedFields returns the `Type.field` names selected by any @key, @requires or @provides.
+// @provides is included because fields a subgraph provides must also be marked @external.
+func (r *FederationExternalUnused) collectReferencedFields(schema *ast.Schema) map[string]bool {
+	referenced := make(map[string]bool)
+
+	for _, def := range schema.Types {
+		if def.BuiltIn || (def.Kind != ast.Object && def.Kind != ast.Interface) {
+			continue
+		}
+
+		for _, directive := range def.Directives.ForNames("key") {
+			r.markSelection(schema, def, directive, referenced)
+		}
+
+		for _, field := range def.Fields {
+			for _, directive := range field.Directives.ForNames("requires") {
+				r.markSelection(schema, def, directive, referenced)
+			}
+			if returnType := schema.Types[field.Type.Name()]; returnType != nil {
+				for _, directive := range field.Directives.ForNames("provides") {
+					r.markSelection(schema, returnType, directive, referenced)
+				}
+			}
+		}
+	}
+
+	return referenced
+}
+
+// markSelection parses a directive's fields argument and marks every selected field, including nested ones
+func (r *FederationExternalUnused) markSelection(schema *ast.Schema, def *ast.Definition, directive *ast.Directive, referenced map[string]bool) {
+	fieldsArg := directive.Arguments.ForName("fields")
+	if fieldsArg == nil || fieldsArg.Value == nil || fieldsArg.Value.Kind != ast.StringValue {
+		return
+	}
+
+	// Unparsable selections are reported by the rules validating them
+	selectionSet, err := parseFieldSelection(def.Name, fieldsArg.Value.Raw)
+	if err != nil {
+		return
+	}
+	r.markSelectionSet(schema, def, selectionSet, referenced)
+}
+
+// markSelectionSet marks the fields of a selection set against the type they are selected from
+func (r *FederationExternalUnused) markSelectionSet(schema *ast.Schema, def *ast.Definition, selectionSet ast.SelectionSet, referenced map[string]bool) {
+	walkFieldSelection(schema, def, selectionSet, func(owner *ast.Definition, sel *ast.Field, _ *ast.FieldDefinition) {
+		referenced[owner.Name+"."+sel.Name] = true
+	}, nil)
+}
@@ -0,0 +1,51 @@
+package rules
+
+import "testing"
+
+func TestFederationExternalUnused(t *testing.T) {
+	rule := NewFederationExternalUnused()
+
+	t.Run("should flag @external fields nothing references", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Product @key(fields: "upc") {
+			upc: String! @external
+			sku: String @external
+			weight: Float @external
+			shippingEstimate: Float @requires(fields: "weight")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "federation-external-unused") != 1 {
+			t.Errorf("Expected 1 error for unused @external fields, got %d", countRuleErrors(errors, "federation-external-unused"))
+		}
+
+		expectedMessage := "Field `Product.sku` is marked @external but is not referenced by any @key or @requires."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should count nested and @provides references", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Dimensions {
+			width: Float @external
+		}
+
+		type User @key(fields: "id") {
+			id: ID!
+			name: String @external
+		}
+
+		type Product @key(fields: "id") {
+			id: ID!
+			size: Dimensions @external
+			shippingEstimate: Float @requires(fields: "size { width }")
+			owner: User @provides(fields: "name")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "federation-external-unused") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// FederationProvidesFieldsExist checks that @provides selections reference fields of the field's return type
+type FederationProvidesFieldsExist struct {
+	keyLint *KeyDirectivesLint
+}
+
+// NewFederationProvidesFieldsExist creates a new instance of the FederationProvidesFieldsExist rule
+func NewFederationProvidesFieldsExist() *FederationProvidesFieldsExist {
+	return &FederationProvidesFieldsExist{keyLint: NewKeyDirectivesLint()}
+}
+
+// Name returns the rule name
+func (r *FederationProvidesFieldsExist) Name() string {
+	return "federation-provides-fields-exist"
+}
+
+// Description returns what this rule checks
+func (r *FederationProvidesFieldsExist) Description() string {
+	return "Fields referenced by @provides must exist on the return type of the annotated field, including nested selections"
+}
+
+// Check validates the field selections of @provides directives
+func (r *FederationProvidesFieldsExist) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// @provides selects fields of the base return type, through any list and non-null wrappers
+			returnType := schema.Types[field.Type.Name()]
+			if returnType == nil {
+				continue
+			}
+
+			for _, directive := range field.Directives.ForNames("provides") {
+				errors = append(errors, checkFederationFieldSelection(schema, source, r.Name(), r.keyLint, def, field, directive, returnType)...)
+			}
+		}
+	}
+
+	return errors
+}
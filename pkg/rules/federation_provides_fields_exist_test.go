@@ -0,0 +1,82 @@
+package rules
+
+import "testing"
+
+func TestFederationProvidesFieldsExist(t *testing.T) {
+	rule := NewFederationProvidesFieldsExist()
+
+	t.Run("should flag @provides references missing on the return type", func(t *testing.T) {
+		schema := federationDirectives + `
+		type User @key(fields: "id") {
+			id: ID!
+			name: String @external
+		}
+
+		type Review {
+			author: User @provides(fields: "email")
+			reviewers: [User!]! @provides(fields: "name nickname")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "federation-provides-fields-exist") != 2 {
+			t.Errorf("Expected 2 errors for missing @provides fields, got %d", countRuleErrors(errors, "federation-provides-fields-exist"))
+		}
+
+		expectedMessages := []string{
+			"Field `Review.author` @provides references `email` which does not exist on `User`.",
+			"Field `Review.reviewers` @provides references `nickname` which does not exist on `User`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should report nested and unparsable @provides selections exactly once", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Address {
+			zip: String
+		}
+
+		type User @key(fields: "id") {
+			id: ID!
+			address: Address @external
+		}
+
+		type Review {
+			author: User @provides(fields: "address { street }")
+			editor: User @provides(fields: "address {")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "federation-provides-fields-exist") != 2 {
+			t.Errorf("Expected 2 errors, got %d", countRuleErrors(errors, "federation-provides-fields-exist"))
+		}
+		if !containsError(errors, "Field `Review.author` @provides references `street` which does not exist on `Address`.") {
+			t.Errorf("Expected the nested missing field to be reported, got %v", errors)
+		}
+
+		selectionErrors := runRule(t, NewDirectiveSelectionTypeValid(), schema)
+		if countRuleErrors(selectionErrors, "directive-selection-type-valid") > 0 {
+			t.Errorf("Expected directive-selection-type-valid to leave these to this rule, got %v", selectionErrors)
+		}
+	})
+
+	t.Run("should pass fields that exist on the return type", func(t *testing.T) {
+		schema := federationDirectives + `
+		type User @key(fields: "id") {
+			id: ID!
+			name: String @external
+		}
+
+		type Review {
+			author: User @provides(fields: "name")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "federation-provides-fields-exist") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "federation-provides-fields-exist"))
+		}
+	})
+}
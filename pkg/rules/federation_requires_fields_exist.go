@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// FederationRequiresFieldsExist checks that @requires selections reference fields of the owning type
+type FederationRequiresFieldsExist struct {
+	keyLint *KeyDirectivesLint
+}
+
+// NewFederationRequiresFieldsExist creates a new instance of the FederationRequiresFieldsExist rule
+func NewFederationRequiresFieldsExist() *FederationRequiresFieldsExist {
+	return &FederationRequiresFieldsExist{keyLint: NewKeyDirectivesLint()}
+}
+
+// Name returns the rule name
+func (r *FederationRequiresFieldsExist) Name() string {
+	return "federation-requires-fields-exist"
+}
+
+// Description returns what this rule checks
+func (r *FederationRequiresFieldsExist) Description() string {
+	return "Fields referenced by @requires must exist on the owning type, including nested selections, and be space-separated"
+}
+
+// Check validates the field selections of @requires directives
+func (r *FederationRequiresFieldsExist) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			for _, directive := range field.Directives.ForNames("requires") {
+				// @requires selects fields of the type that owns the field
+				errors = append(errors, checkFederationFieldSelection(schema, source, r.Name(), r.keyLint, def, field, directive, def)...)
+			}
+		}
+	}
+
+	return errors
+}
+
+// missingSelectionField is a selected field that doesn't exist on the type it was selected from
+type missingSelectionField struct {
+	name     string
+	typeName string
+}
+
+// checkFederationFieldSelection validates the `fields` argument of a field-level federation directive against target.
+// It is shared by the @requires and @provides rules, which differ only in the type they select from.
+func checkFederationFieldSelection(schema *ast.Schema, source *ast.Source, ruleName string, keyLint *KeyDirectivesLint,
+	owner *ast.Definition, field *ast.FieldDefinition, directive *ast.Directive, target *ast.Definition) []types.LintError {
+	var errors []types.LintError
+
+	fieldsArg := directive.Arguments.ForName("fields")
+	if fieldsArg == nil || fieldsArg.Value == nil || fieldsArg.Value.Kind != ast.StringValue {
+		return errors
+	}
+	fields := fieldsArg.Value.Raw
+
+	line, column := 1, 1
+	if directive.Position != nil {
+		line = directive.Position.Line
+		column = directive.Position.Column
+	}
+
+	var messages []string
+	if keyLint.hasCommaSeparatedFields(fields) {
+		messages = append(messages, fmt.Sprintf("Field `%s.%s` @%s fields must be space-separated, not comma-separated: `%s`.", owner.Name, field.Name, directive.Name, fields))
+	} else if selectionSet, err := parseFieldSelection(target.Name, fields); err != nil {
+		messages = append(messages, fmt.Sprintf("Field `%s.%s` @%s has an invalid field selection `%s`: %v.", owner.Name, field.Name, directive.Name, fields, err))
+	} else {
+		for _, missing := range findMissingSelectionFields(schema, target, selectionSet) {
+			messages = append(messages, fmt.Sprintf("Field `%s.%s` @%s references `%s` which does not exist on `%s`.", owner.Name, field.Name, directive.Name, missing.name, missing.typeName))
+		}
+	}
+
+	for _, message := range messages {
+		errors = append(errors, types.LintError{
+			Message: message,
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: ruleName,
+		})
+	}
+
+	return errors
+}
+
+// findMissingSelectionFields returns the selected fields that don't exist on def, recursing into nested selections
+func findMissingSelectionFields(schema *ast.Schema, def *ast.Definition, selectionSet ast.SelectionSet) []missingSelectionField {
+	var missing []missingSelectionField
+	walkFieldSelection(schema, def, selectionSet, func(owner *ast.Definition, sel *ast.Field, field *ast.FieldDefinition) {
+		if field == nil {
+			missing = append(missing, missingSelectionField{name: sel.Name, typeName: owner.Name})
+		}
+	}, nil)
+	return missing
+}
@@ -0,0 +1,71 @@
+package rules
+
+import "testing"
+
+func TestFederationRequiresFieldsExist(t *testing.T) {
+	rule := NewFederationRequiresFieldsExist()
+
+	t.Run("should flag @requires references to missing fields", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Money {
+			amount: Float
+		}
+
+		type Product @key(fields: "id") {
+			id: ID!
+			cost: Money @external
+			price: Float @requires(fields: "currency")
+			tax: Float @requires(fields: "cost { amount currency }")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "federation-requires-fields-exist") != 2 {
+			t.Errorf("Expected 2 errors for missing @requires fields, got %d", countRuleErrors(errors, "federation-requires-fields-exist"))
+		}
+
+		expectedMessages := []string{
+			"Field `Product.price` @requires references `currency` which does not exist on `Product`.",
+			"Field `Product.tax` @requires references `currency` which does not exist on `Money`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should flag comma-separated fields", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Product @key(fields: "id") {
+			id: ID!
+			weight: Float @external
+			size: Float @external
+			shipping: Float @requires(fields: "weight, size")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Field `Product.shipping` @requires fields must be space-separated, not comma-separated: `weight, size`."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should pass existing fields", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Money {
+			amount: Float
+		}
+
+		type Product @key(fields: "id") {
+			id: ID!
+			weight: Float @external
+			cost: Money @external
+			shipping: Float @requires(fields: "weight cost { amount }")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "federation-requires-fields-exist") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "federation-requires-fields-exist"))
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"sort"
+	"unicode"
+
+	"github.com/nishant-rn/gqlparser/v2/ast"
+	"github.com/nishant-rn/gqlparser/v2/lexer"
+)
+
+// textEdit replaces the runes in [start, end) of a source with text
+type textEdit struct {
+	start int
+	end   int
+	text  string
+}
+
+// applyEdits applies non-overlapping edits to input. Offsets are in runes, like ast.Position.
+func applyEdits(input []rune, edits []textEdit) string {
+	sort.Slice(edits, func(i, j int) bool {
+		return edits[i].start > edits[j].start
+	})
+
+	// Apply from the end so earlier offsets stay valid
+	runes := input
+	for _, edit := range edits {
+		var edited []rune
+		edited = append(edited, runes[:edit.start]...)
+		edited = append(edited, []rune(edit.text)...)
+		edited = append(edited, runes[edit.end:]...)
+		runes = edited
+	}
+	return string(runes)
+}
+
+// lineStart returns the offset of the first rune on the line containing pos
+func lineStart(input []rune, pos int) int {
+	for pos > 0 && input[pos-1] != '\n' {
+		pos--
+	}
+	return pos
+}
+
+// startsLine checks if only whitespace precedes pos on its line
+func startsLine(input []rune, pos int) bool {
+	for i := lineStart(input, pos); i < pos; i++ {
+		if !unicode.IsSpace(input[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// closingBrace returns the offset of the `}` that closes the block containing from,
+// skipping nested braces, parentheses and brackets, or -1 if it can't be found
+func closingBrace(input []rune, from int) int {
+	lex := lexer.New(&ast.Source{Input: string(input[from:])})
+	depth := 0
+	for {
+		tok, err := lex.ReadToken()
+		if err != nil || tok.Kind == lexer.EOF {
+			return -1
+		}
+		switch tok.Kind {
+		case lexer.BraceL, lexer.ParenL, lexer.BracketL:
+			depth++
+		case lexer.ParenR, lexer.BracketR:
+			depth--
+		case lexer.BraceR:
+			if depth == 0 {
+				return from + tok.Pos.Start
+			}
+			depth--
+		}
+	}
+}
+
+// leadingStart returns the offset where a member's text begins, including the comments attached before it.
+// A comment that shares its line with earlier text trails the previous member, so it is not included.
+func leadingStart(input []rune, pos *ast.Position, comments ...*ast.CommentGroup) int {
+	start := pos.Start
+	for _, group := range comments {
+		if group == nil {
+			continue
+		}
+		for _, comment := range group.List {
+			if comment.Position == nil || comment.Position.Start >= start {
+				continue
+			}
+			if startsLine(input, comment.Position.Start) {
+				start = comment.Position.Start
+			}
+		}
+	}
+	return start
+}
+
+// endsWithComment checks if the last token of text is a `#` comment, which runs to the end of its line
+func endsWithComment(text string) bool {
+	lex := lexer.New(&ast.Source{Input: text})
+	last := lexer.EOF
+	for {
+		tok, err := lex.ReadToken()
+		if err != nil {
+			return false
+		}
+		if tok.Kind == lexer.EOF {
+			return last == lexer.Comment
+		}
+		last = tok.Kind
+	}
+}
+
+// contentEnd returns the offset just past the last non-whitespace rune in [start, end)
+func contentEnd(input []rune, start, end int) int {
+	for end > start && unicode.IsSpace(input[end-1]) {
+		end--
+	}
+	return end
+}
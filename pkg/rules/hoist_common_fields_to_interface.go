@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// minImplementersToHoist is the number of implementers needed before shared fields are worth hoisting;
+// with a single implementer every field would be "shared"
+const minImplementersToHoist = 2
+
+// HoistCommonFieldsToInterface checks for fields that every implementer of an interface declares on its own
+type HoistCommonFieldsToInterface struct{}
+
+// NewHoistCommonFieldsToInterface creates a new instance of the HoistCommonFieldsToInterface rule
+func NewHoistCommonFieldsToInterface() *HoistCommonFieldsToInterface {
+	return &HoistCommonFieldsToInterface{}
+}
+
+// Name returns the rule name
+func (r *HoistCommonFieldsToInterface) Name() string {
+	return "hoist-common-fields-to-interface"
+}
+
+// Description returns what this rule checks
+func (r *HoistCommonFieldsToInterface) Description() string {
+	return "Fields declared by every implementer of an interface should be declared on the interface (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *HoistCommonFieldsToInterface) OptIn() bool {
+	return true
+}
+
+// Check validates that fields shared by all implementers are declared on their interface
+func (r *HoistCommonFieldsToInterface) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	// Build the implementer set of each interface
+	implementers := make(map[string][]*ast.Definition)
+	for _, def := range schema.Types {
+		if def.BuiltIn {
+			continue
+		}
+		for _, interfaceName := range def.Interfaces {
+			implementers[interfaceName] = append(implementers[interfaceName], def)
+		}
+	}
+
+	for interfaceName, impls := range implementers {
+		iface := schema.Types[interfaceName]
+		if iface == nil || iface.Kind != ast.Interface || len(impls) < minImplementersToHoist {
+			continue
+		}
+
+		for _, fieldName := range r.sharedFields(impls) {
+			if iface.Fields.ForName(fieldName) != nil {
+				continue
+			}
+
+			line, column := 1, 1
+			if iface.Position != nil {
+				line = iface.Position.Line
+				column = iface.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s` is implemented by all types implementing `%s` but not declared on the interface; consider hoisting it.", fieldName, interfaceName),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// sharedFields returns the sorted names of fields declared by every one of the given types
+func (r *HoistCommonFieldsToInterface) sharedFields(defs []*ast.Definition) []string {
+	counts := make(map[string]int)
+	for _, def := range defs {
+		for _, field := range def.Fields {
+			if !strings.HasPrefix(field.Name, "__") {
+				counts[field.Name]++
+			}
+		}
+	}
+
+	var shared []string
+	for name, count := range counts {
+		if count == len(defs) {
+			shared = append(shared, name)
+		}
+	}
+	sort.Strings(shared)
+	return shared
+}
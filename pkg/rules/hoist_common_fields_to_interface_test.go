@@ -0,0 +1,65 @@
+package rules
+
+import "testing"
+
+func TestHoistCommonFieldsToInterface(t *testing.T) {
+	rule := NewHoistCommonFieldsToInterface()
+
+	t.Run("should flag fields shared by all implementers", func(t *testing.T) {
+		schema := `
+		interface Node {
+			id: ID!
+		}
+
+		type User implements Node {
+			id: ID!
+			createdAt: String
+			name: String
+		}
+
+		type Post implements Node {
+			id: ID!
+			createdAt: String
+			title: String
+		}
+
+		type Comment implements Node {
+			id: ID!
+			createdAt: String
+			name: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "hoist-common-fields-to-interface") != 1 {
+			t.Errorf("Expected 1 error for shared fields, got %d", countRuleErrors(errors, "hoist-common-fields-to-interface"))
+		}
+
+		expectedMessage := "Field `createdAt` is implemented by all types implementing `Node` but not declared on the interface; consider hoisting it."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should skip interfaces with a single implementer", func(t *testing.T) {
+		schema := `
+		interface Node {
+			id: ID!
+		}
+
+		type User implements Node {
+			id: ID!
+			createdAt: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "hoist-common-fields-to-interface") > 0 {
+			t.Error("Expected no errors with a single implementer")
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected hoist-common-fields-to-interface to be opt-in")
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// IdArgumentSemantics checks that arguments typed `ID` are identifiers
+type IdArgumentSemantics struct{}
+
+// NewIdArgumentSemantics creates a new instance of the IdArgumentSemantics rule
+func NewIdArgumentSemantics() *IdArgumentSemantics {
+	return &IdArgumentSemantics{}
+}
+
+// Name returns the rule name
+func (r *IdArgumentSemantics) Name() string {
+	return "id-argument-semantics"
+}
+
+// Description returns what this rule checks
+func (r *IdArgumentSemantics) Description() string {
+	return "Arguments typed `ID` should be identifiers such as `id`, `userId` or `ids`; use `String` for tokens and names"
+}
+
+// Check validates that `ID` arguments have identifier names
+func (r *IdArgumentSemantics) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			for _, arg := range field.Arguments {
+				if arg.Type.Name() != "ID" || isIdentifierName(arg.Name) {
+					continue
+				}
+
+				line, column := 1, 1
+				if arg.Position != nil {
+					line = arg.Position.Line
+					column = arg.Position.Column
+				}
+
+				errors = append(errors, types.LintError{
+					Message: fmt.Sprintf("Argument `%s` on `%s.%s` is typed `ID` but isn't an identifier; use `String`.", arg.Name, def.Name, field.Name),
+					Location: types.Location{
+						Line:   line,
+						Column: column,
+						File:   source.Name,
+					},
+					Rule: r.Name(),
+				})
+			}
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,52 @@
+package rules
+
+import "testing"
+
+func TestIdArgumentSemantics(t *testing.T) {
+	rule := NewIdArgumentSemantics()
+
+	t.Run("should allow identifier arguments typed ID", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type Query {
+			user(id: ID!): User
+			users(ids: [ID!]!): [User]
+			team(ownerId: ID, parentID: ID): User
+			feed(token: String): [User]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "id-argument-semantics") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag non-identifier arguments typed ID", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type Query {
+			feed(token: ID): [User]
+			search(name: ID!): [User]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Argument `token` on `Query.feed` is typed `ID` but isn't an identifier; use `String`.",
+			"Argument `name` on `Query.search` is typed `ID` but isn't an identifier; use `String`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "id-argument-semantics") != 2 {
+			t.Errorf("Expected 2 errors, got %v", errors)
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultInputTypeSuffixes are the input type name suffixes stripped by NewInputOutputFieldParity when none are configured
+var DefaultInputTypeSuffixes = []string{"Input"}
+
+// DefaultInputOperationPrefixes are the input type name prefixes stripped by NewInputOutputFieldParity when none are configured
+var DefaultInputOperationPrefixes = []string{"Create", "Update"}
+
+// InputOutputFieldParity checks that fields shared by an input type and its output type have matching types
+type InputOutputFieldParity struct {
+	suffixes []string
+	prefixes []string
+}
+
+// NewInputOutputFieldParity creates a new instance of the InputOutputFieldParity rule.
+// An input type maps to the output type named by stripping one of suffixes and, if needed, one of prefixes,
+// e.g. `CreateUserInput` maps to `User`. Empty arguments fall back to DefaultInputTypeSuffixes and
+// DefaultInputOperationPrefixes.
+func NewInputOutputFieldParity(suffixes, prefixes []string) *InputOutputFieldParity {
+	if len(suffixes) == 0 {
+		suffixes = DefaultInputTypeSuffixes
+	}
+	if len(prefixes) == 0 {
+		prefixes = DefaultInputOperationPrefixes
+	}
+	return &InputOutputFieldParity{suffixes: suffixes, prefixes: prefixes}
+}
+
+// Name returns the rule name
+func (r *InputOutputFieldParity) Name() string {
+	return "input-output-field-parity"
+}
+
+// Description returns what this rule checks
+func (r *InputOutputFieldParity) Description() string {
+	return "Fields present on both an input type and its matching output type (e.g. `CreateUserInput` and `User`) should have the same type, ignoring nullability (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *InputOutputFieldParity) OptIn() bool {
+	return true
+}
+
+// Check validates that input fields match the types of their output counterparts
+func (r *InputOutputFieldParity) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.InputObject {
+			continue
+		}
+
+		output := schema.Types[r.outputTypeName(schema, def.Name)]
+		if output == nil {
+			continue
+		}
+
+		for _, inputField := range def.Fields {
+			// Fields absent from the output type are skipped
+			outputField := output.Fields.ForName(inputField.Name)
+			if outputField == nil || r.typesMatch(schema, inputField.Type, outputField.Type) {
+				continue
+			}
+
+			line, column := 1, 1
+			if inputField.Position != nil {
+				line = inputField.Position.Line
+				column = inputField.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s` is `%s` on `%s` but `%s` on `%s`.",
+					inputField.Name, outputField.Type.String(), output.Name, inputField.Type.String(), def.Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// outputTypeName returns the name of the object or interface type an input type maps to, or "" if there is none
+func (r *InputOutputFieldParity) outputTypeName(schema *ast.Schema, inputName string) string {
+	for _, suffix := range r.suffixes {
+		base := strings.TrimSuffix(inputName, suffix)
+		if base == inputName || base == "" {
+			continue
+		}
+		if r.isOutputType(schema, base) {
+			return base
+		}
+		for _, prefix := range r.prefixes {
+			name := strings.TrimPrefix(base, prefix)
+			if name != base && r.isOutputType(schema, name) {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// isOutputType checks if a type name refers to an object or interface type
+func (r *InputOutputFieldParity) isOutputType(schema *ast.Schema, name string) bool {
+	def := schema.Types[name]
+	return def != nil && (def.Kind == ast.Object || def.Kind == ast.Interface)
+}
+
+// typesMatch compares an input and an output type ignoring nullability. Named input types also match
+// the output type they map to, so `address: AddressInput` matches `address: Address`.
+func (r *InputOutputFieldParity) typesMatch(schema *ast.Schema, inputType, outputType *ast.Type) bool {
+	if (inputType.Elem != nil) != (outputType.Elem != nil) {
+		return false
+	}
+	if inputType.Elem != nil {
+		return r.typesMatch(schema, inputType.Elem, outputType.Elem)
+	}
+	return inputType.NamedType == outputType.NamedType || r.outputTypeName(schema, inputType.NamedType) == outputType.NamedType
+}
@@ -0,0 +1,121 @@
+package rules
+
+import "testing"
+
+func TestInputOutputFieldParity(t *testing.T) {
+	t.Run("should allow matching types and nullability differences", func(t *testing.T) {
+		rule := NewInputOutputFieldParity(nil, nil)
+		schema := `
+		type Address {
+			city: String!
+		}
+
+		input AddressInput {
+			city: String
+		}
+
+		type User {
+			email: String!
+			tags: [String!]!
+			address: Address
+		}
+
+		input CreateUserInput {
+			email: String!
+			tags: [String!]
+			address: AddressInput
+			password: String!
+		}
+
+		input UpdateUserInput {
+			email: String
+		}
+
+		type Query {
+			user: User
+		}
+
+		type Mutation {
+			createUser(input: CreateUserInput!): User
+			updateUser(input: UpdateUserInput!): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "input-output-field-parity") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag mismatched field types", func(t *testing.T) {
+		rule := NewInputOutputFieldParity(nil, nil)
+		schema := `
+		type User {
+			email: String!
+			tags: [String!]!
+		}
+
+		input CreateUserInput {
+			email: Int
+			tags: String
+		}
+
+		type Query {
+			user: User
+		}
+
+		type Mutation {
+			createUser(input: CreateUserInput!): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Field `email` is `String!` on `User` but `Int` on `CreateUserInput`.",
+			"Field `tags` is `[String!]!` on `User` but `String` on `CreateUserInput`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "input-output-field-parity") != 2 {
+			t.Errorf("Expected 2 errors, got %v", errors)
+		}
+	})
+
+	t.Run("should use the configured name mapping", func(t *testing.T) {
+		rule := NewInputOutputFieldParity([]string{"Params"}, []string{"Register"})
+		schema := `
+		type User {
+			email: String!
+		}
+
+		input RegisterUserParams {
+			email: Int
+		}
+
+		input CreateUserInput {
+			email: Int
+		}
+
+		type Query {
+			user: User
+		}
+
+		type Mutation {
+			registerUser(params: RegisterUserParams!): User
+			createUser(input: CreateUserInput!): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Field `email` is `String!` on `User` but `Int` on `RegisterUserParams`."
+		if countRuleErrors(errors, "input-output-field-parity") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !NewInputOutputFieldParity(nil, nil).OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// InterfaceFieldCompatibility checks that implementations don't widen the types of interface fields
+type InterfaceFieldCompatibility struct{}
+
+// NewInterfaceFieldCompatibility creates a new instance of the InterfaceFieldCompatibility rule
+func NewInterfaceFieldCompatibility() *InterfaceFieldCompatibility {
+	return &InterfaceFieldCompatibility{}
+}
+
+// Name returns the rule name
+func (r *InterfaceFieldCompatibility) Name() string {
+	return "interface-field-compatibility"
+}
+
+// Description returns what this rule checks
+func (r *InterfaceFieldCompatibility) Description() string {
+	return "Fields of types implementing an interface must have the interface field's type or a narrower one (e.g. non-null instead of nullable). The schema parser rejects most mismatches already; this guards schemas that reach rules without full validation"
+}
+
+// Check validates that implemented interface fields keep compatible types
+func (r *InterfaceFieldCompatibility) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, interfaceName := range def.Interfaces {
+			interfaceDef := schema.Types[interfaceName]
+			if interfaceDef == nil {
+				continue
+			}
+
+			for _, interfaceField := range interfaceDef.Fields {
+				// Missing fields are reported by the schema parser
+				field := def.Fields.ForName(interfaceField.Name)
+				if field == nil || r.isSubtype(schema, field.Type, interfaceField.Type) {
+					continue
+				}
+
+				message := fmt.Sprintf("Type `%s` implements `%s` but field `%s` has type `%s` where the interface requires `%s`.",
+					def.Name, interfaceName, field.Name, field.Type.String(), interfaceField.Type.String())
+				if r.onlyNullabilityDiffers(schema, field.Type, interfaceField.Type) {
+					message = fmt.Sprintf("Type `%s` implements `%s` but field `%s` is nullable where the interface requires `%s`.",
+						def.Name, interfaceName, field.Name, interfaceField.Type.String())
+				}
+
+				line, column := 1, 1
+				if field.Position != nil {
+					line = field.Position.Line
+					column = field.Position.Column
+				}
+
+				errors = append(errors, types.LintError{
+					Message: message,
+					Location: types.Location{
+						Line:   line,
+						Column: column,
+						File:   source.Name,
+					},
+					Rule: r.Name(),
+				})
+			}
+		}
+	}
+
+	return errors
+}
+
+// isSubtype checks if a field of type impl can stand in for a field of type iface.
+// Non-null may narrow nullable, and abstract types may narrow to their possible types.
+func (r *InterfaceFieldCompatibility) isSubtype(schema *ast.Schema, impl, iface *ast.Type) bool {
+	if iface.NonNull && !impl.NonNull {
+		return false
+	}
+
+	if iface.Elem != nil || impl.Elem != nil {
+		if iface.Elem == nil || impl.Elem == nil {
+			return false
+		}
+		return r.isSubtype(schema, impl.Elem, iface.Elem)
+	}
+
+	if impl.NamedType == iface.NamedType {
+		return true
+	}
+
+	abstract := schema.Types[iface.NamedType]
+	if abstract == nil || !abstract.IsAbstractType() {
+		return false
+	}
+	for _, possible := range schema.GetPossibleTypes(abstract) {
+		if possible.Name == impl.NamedType {
+			return true
+		}
+	}
+	return false
+}
+
+// onlyNullabilityDiffers checks if impl would be compatible once it is made non-null
+func (r *InterfaceFieldCompatibility) onlyNullabilityDiffers(schema *ast.Schema, impl, iface *ast.Type) bool {
+	nonNull := *impl
+	nonNull.NonNull = true
+	return iface.NonNull && !impl.NonNull && r.isSubtype(schema, &nonNull, iface)
+}
@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+func TestInterfaceFieldCompatibility(t *testing.T) {
+	rule := NewInterfaceFieldCompatibility()
+
+	// The schema parser rejects incompatible implementations, so the tests
+	// load a valid schema and change field types afterwards
+	const schemaStr = `
+	interface Node {
+		id: ID!
+		tags: [String!]
+		friend: Node
+	}
+
+	type User implements Node {
+		id: ID!
+		tags: [String!]
+		friend: Node
+	}
+
+	type Query {
+		user: User
+	}
+	`
+
+	t.Run("should allow identical and narrower field types", func(t *testing.T) {
+		schema, source := parseSchema(t, schemaStr)
+		user := schema.Types["User"]
+		user.Fields.ForName("tags").Type = ast.NonNullListType(ast.NonNullNamedType("String", nil), nil)
+		user.Fields.ForName("friend").Type = ast.NamedType("User", nil)
+
+		errors := rule.Check(schema, source)
+		if countRuleErrors(errors, "interface-field-compatibility") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag nullable fields where the interface requires non-null", func(t *testing.T) {
+		schema, source := parseSchema(t, schemaStr)
+		schema.Types["User"].Fields.ForName("id").Type = ast.NamedType("ID", nil)
+
+		errors := rule.Check(schema, source)
+		expectedMessage := "Type `User` implements `Node` but field `id` is nullable where the interface requires `ID!`."
+		if countRuleErrors(errors, "interface-field-compatibility") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should flag incompatible field types", func(t *testing.T) {
+		schema, source := parseSchema(t, schemaStr)
+		user := schema.Types["User"]
+		user.Fields.ForName("tags").Type = ast.ListType(ast.NamedType("String", nil), nil)
+		user.Fields.ForName("friend").Type = ast.NamedType("String", nil)
+
+		errors := rule.Check(schema, source)
+		if countRuleErrors(errors, "interface-field-compatibility") != 2 {
+			t.Errorf("Expected 2 errors, got %d", countRuleErrors(errors, "interface-field-compatibility"))
+		}
+
+		expectedMessage := "Type `User` implements `Node` but field `tags` has type `[String]` where the interface requires `[String!]`."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// InterfaceFieldsHaveDescriptions checks that all interface fields have descriptions
+type InterfaceFieldsHaveDescriptions struct{}
+
+// NewInterfaceFieldsHaveDescriptions creates a new instance of the InterfaceFieldsHaveDescriptions rule
+func NewInterfaceFieldsHaveDescriptions() *InterfaceFieldsHaveDescriptions {
+	return &InterfaceFieldsHaveDescriptions{}
+}
+
+// Name returns the rule name
+func (r *InterfaceFieldsHaveDescriptions) Name() string {
+	return "interface-fields-have-descriptions"
+}
+
+// Description returns what this rule checks
+func (r *InterfaceFieldsHaveDescriptions) Description() string {
+	return "All interface fields should have descriptions, since they define the contract every implementer shares"
+}
+
+// Check validates that all interface fields have descriptions
+func (r *InterfaceFieldsHaveDescriptions) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") || def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			if field.Description != "" {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("The interface field `%s.%s` is missing a description.", def.Name, field.Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
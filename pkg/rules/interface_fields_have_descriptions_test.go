@@ -0,0 +1,43 @@
+package rules
+
+import "testing"
+
+func TestInterfaceFieldsHaveDescriptions(t *testing.T) {
+	rule := NewInterfaceFieldsHaveDescriptions()
+
+	t.Run("should flag interface fields without descriptions", func(t *testing.T) {
+		schema := `
+		interface Node {
+			id: ID!
+			"""When the node was created"""
+			createdAt: String
+		}
+
+		type User implements Node {
+			id: ID!
+			createdAt: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "interface-fields-have-descriptions") != 1 {
+			t.Errorf("Expected 1 error for interface field without description, got %d", countRuleErrors(errors, "interface-fields-have-descriptions"))
+		}
+
+		expectedMessage := "The interface field `Node.id` is missing a description."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should ignore object type fields", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "interface-fields-have-descriptions") > 0 {
+			t.Error("Expected no errors for object type fields")
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultInterfaceActionVerbs are the leading verbs flagged by NewInterfaceNamingStyle when none are configured
+var DefaultInterfaceActionVerbs = []string{"Create", "Update", "Get", "Delete"}
+
+// InterfaceNamingStyle checks that interfaces are named after capabilities or entities rather than actions
+type InterfaceNamingStyle struct {
+	verbs []string
+}
+
+// NewInterfaceNamingStyle creates a new instance of the InterfaceNamingStyle rule.
+// An interface is flagged when its first PascalCase word is one of verbs.
+// If verbs is empty, DefaultInterfaceActionVerbs is used.
+func NewInterfaceNamingStyle(verbs []string) *InterfaceNamingStyle {
+	if len(verbs) == 0 {
+		verbs = DefaultInterfaceActionVerbs
+	}
+	return &InterfaceNamingStyle{verbs: verbs}
+}
+
+// Name returns the rule name
+func (r *InterfaceNamingStyle) Name() string {
+	return "interface-naming-style"
+}
+
+// Description returns what this rule checks
+func (r *InterfaceNamingStyle) Description() string {
+	return "Interface names should describe capabilities or entities (e.g. `Timestamped`, `Node`), not start with a verb like `Create` (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *InterfaceNamingStyle) OptIn() bool {
+	return true
+}
+
+// Check validates that interface names don't start with an action verb
+func (r *InterfaceNamingStyle) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Interface {
+			continue
+		}
+
+		words := splitCamelCase(def.Name)
+		if len(words) < 2 || !contains(r.verbs, words[0]) {
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Interface `%s` is named like an action; interfaces should describe capabilities or entities.", def.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
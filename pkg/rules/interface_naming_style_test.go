@@ -0,0 +1,90 @@
+package rules
+
+import "testing"
+
+func TestInterfaceNamingStyle(t *testing.T) {
+	t.Run("should allow capability and entity names", func(t *testing.T) {
+		rule := NewInterfaceNamingStyle(nil)
+		schema := `
+		interface Node {
+			id: ID!
+		}
+
+		interface Timestamped {
+			createdAt: String
+		}
+
+		interface Gettable {
+			value: String
+		}
+
+		type User implements Node & Timestamped & Gettable {
+			id: ID!
+			createdAt: String
+			value: String
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "interface-naming-style") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag interfaces named like actions", func(t *testing.T) {
+		rule := NewInterfaceNamingStyle(nil)
+		schema := `
+		interface CreateUser {
+			id: ID!
+		}
+
+		type User implements CreateUser {
+			id: ID!
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Interface `CreateUser` is named like an action; interfaces should describe capabilities or entities."
+		if countRuleErrors(errors, "interface-naming-style") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should use the configured verbs", func(t *testing.T) {
+		rule := NewInterfaceNamingStyle([]string{"Fetch"})
+		schema := `
+		interface FetchUser {
+			id: ID!
+		}
+
+		interface CreateUser {
+			id: ID!
+		}
+
+		type User implements FetchUser & CreateUser {
+			id: ID!
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Interface `FetchUser` is named like an action; interfaces should describe capabilities or entities."
+		if countRuleErrors(errors, "interface-naming-style") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !NewInterfaceNamingStyle(nil).OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/anirudhraja/gqllinter/pkg/types"
 	"github.com/nishant-rn/gqlparser/v2/ast"
-	"github.com/nishant-rn/gqlparser/v2/parser"
 )
 
 // KeyDirectivesLint checks @key directive validation rules
@@ -119,8 +118,7 @@ func (r *KeyDirectivesLint) validateKeyFields(objectDef *ast.Definition, keyDire
 		return errors
 	}
 
-	query := fmt.Sprintf("fragment x on %s { %s }", objectDef.Name, fieldsString)
-	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	selectionSet, err := parseFieldSelection(objectDef.Name, fieldsString)
 	if err != nil {
 		errors = append(errors, types.LintError{
 			Message: fmt.Sprintf("Failed to parse fields in @key directive for object '%s': %v", objectDef.Name, err),
@@ -131,9 +129,9 @@ func (r *KeyDirectivesLint) validateKeyFields(objectDef *ast.Definition, keyDire
 			},
 			Rule: r.Name(),
 		})
+		return errors
 	}
 
-	selectionSet := doc.Fragments[0].SelectionSet
 	for _, sel := range selectionSet {
 		fieldSel, ok := sel.(*ast.Field)
 		if !ok {
@@ -433,15 +431,13 @@ func (r *KeyDirectivesLint) checkResolvableFalseIncludesAllFields(objectDef *ast
 // parseResolvableFalseKeyFields extracts individual field names from a fields string using fragment parsing
 func (r *KeyDirectivesLint) parseResolvableFalseKeyFields(fieldsString string, objectDef *ast.Definition) []string {
 	// Use the same fragment parsing approach as the main validation
-	query := fmt.Sprintf("fragment x on %s { %s }", objectDef.Name, fieldsString)
-	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	selectionSet, err := parseFieldSelection(objectDef.Name, fieldsString)
 	if err != nil {
 		// If parsing fails, return empty slice (error will be caught by other validation)
 		return []string{}
 	}
 
 	var result []string
-	selectionSet := doc.Fragments[0].SelectionSet
 	for _, sel := range selectionSet {
 		fieldSel, ok := sel.(*ast.Field)
 		if !ok {
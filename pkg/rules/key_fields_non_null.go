@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// KeyFieldsNonNull checks that fields referenced by a @key are non-null
+type KeyFieldsNonNull struct {
+	keyLint *KeyDirectivesLint
+}
+
+// NewKeyFieldsNonNull creates a new instance of the KeyFieldsNonNull rule
+func NewKeyFieldsNonNull() *KeyFieldsNonNull {
+	return &KeyFieldsNonNull{keyLint: NewKeyDirectivesLint()}
+}
+
+// Name returns the rule name
+func (r *KeyFieldsNonNull) Name() string {
+	return "key-fields-non-null"
+}
+
+// Description returns what this rule checks
+func (r *KeyFieldsNonNull) Description() string {
+	return "Fields referenced by an entity's @key, including nested key fields, must be non-null so they can identify the entity"
+}
+
+// Check validates that every @key field is non-null
+func (r *KeyFieldsNonNull) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	visitKeyFields(schema, r.keyLint, func(owner *ast.Definition, field *ast.FieldDefinition) {
+		if field.Type.NonNull {
+			return
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Field `%s.%s` used in @key must be non-null (`%s!`).", owner.Name, field.Name, field.Type.String()),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	})
+
+	return errors
+}
+
+// visitKeyFields calls visit once for every field referenced by an entity's @key selections,
+// including fields of nested selections, together with the type that declares the field
+func visitKeyFields(schema *ast.Schema, keyLint *KeyDirectivesLint, visit func(owner *ast.Definition, field *ast.FieldDefinition)) {
+	// A field can appear in several keys, or in nested keys of several entities, but is only visited once
+	visited := make(map[*ast.FieldDefinition]bool)
+
+	for _, def := range schema.Types {
+		// Only object types can be entities
+		if def.Kind != ast.Object {
+			continue
+		}
+
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+
+		for _, directive := range def.Directives.ForNames("key") {
+			fieldsArg := directive.Arguments.ForName("fields")
+			if fieldsArg == nil || fieldsArg.Value == nil || fieldsArg.Value.Kind != ast.StringValue {
+				continue
+			}
+
+			// Malformed selections are reported by key-directive-lint
+			if keyLint.hasCommaSeparatedFields(fieldsArg.Value.Raw) {
+				continue
+			}
+			selectionSet, err := parseFieldSelection(def.Name, fieldsArg.Value.Raw)
+			if err != nil {
+				continue
+			}
+
+			visitSelectedFields(schema, def, selectionSet, visited, visit)
+		}
+	}
+}
+
+// visitSelectedFields visits the existing fields selected from def once each, recursing into nested selections
+func visitSelectedFields(schema *ast.Schema, def *ast.Definition, selectionSet ast.SelectionSet, visited map[*ast.FieldDefinition]bool, visit func(owner *ast.Definition, field *ast.FieldDefinition)) {
+	walkFieldSelection(schema, def, selectionSet, func(owner *ast.Definition, _ *ast.Field, field *ast.FieldDefinition) {
+		// Fields that don't exist are reported by key-directive-lint
+		if field == nil || visited[field] {
+			return
+		}
+		visited[field] = true
+		visit(owner, field)
+	}, nil)
+}
@@ -0,0 +1,60 @@
+package rules
+
+import "testing"
+
+func TestKeyFieldsNonNull(t *testing.T) {
+	rule := NewKeyFieldsNonNull()
+
+	t.Run("should allow non-null key fields", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Store {
+			id: ID!
+		}
+
+		type Product @key(fields: "upc store { id }") {
+			upc: String!
+			store: Store!
+			name: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "key-fields-non-null") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag nullable key fields", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Product @key(fields: "upc") @key(fields: "sku") @key(fields: "upc sku") {
+			upc: String!
+			sku: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "key-fields-non-null") != 1 {
+			t.Errorf("Expected 1 error for nullable key field, got %d", countRuleErrors(errors, "key-fields-non-null"))
+		}
+
+		expectedMessage := "Field `Product.sku` used in @key must be non-null (`String!`)."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should check leaf fields of nested key selections", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Store {
+			id: ID
+		}
+
+		type Product @key(fields: "store { id }") {
+			store: Store!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Field `Store.id` used in @key must be non-null (`ID!`)."
+		if countRuleErrors(errors, "key-fields-non-null") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// KeyNoDeprecatedFields checks that fields referenced by a @key are not deprecated
+type KeyNoDeprecatedFields struct {
+	keyLint         *KeyDirectivesLint
+	deprecationLint *RequireDeprecationReason
+}
+
+// NewKeyNoDeprecatedFields creates a new instance of the KeyNoDeprecatedFields rule
+func NewKeyNoDeprecatedFields() *KeyNoDeprecatedFields {
+	return &KeyNoDeprecatedFields{
+		keyLint:         NewKeyDirectivesLint(),
+		deprecationLint: NewRequireDeprecationReason(),
+	}
+}
+
+// Name returns the rule name
+func (r *KeyNoDeprecatedFields) Name() string {
+	return "key-no-deprecated-fields"
+}
+
+// Description returns what this rule checks
+func (r *KeyNoDeprecatedFields) Description() string {
+	return "Fields referenced by an entity's @key, including nested key fields, must not be deprecated"
+}
+
+// Check validates that no @key field is deprecated
+func (r *KeyNoDeprecatedFields) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	visitKeyFields(schema, r.keyLint, func(owner *ast.Definition, field *ast.FieldDefinition) {
+		if r.deprecationLint.findDeprecatedDirective(field.Directives) == nil {
+			return
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Field `%s.%s` used in @key is deprecated; keys should reference stable fields.", owner.Name, field.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	})
+
+	return errors
+}
@@ -0,0 +1,52 @@
+package rules
+
+import "testing"
+
+func TestKeyNoDeprecatedFields(t *testing.T) {
+	rule := NewKeyNoDeprecatedFields()
+
+	t.Run("should allow keys on stable fields", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Product @key(fields: "upc") {
+			upc: String!
+			oldSku: String @deprecated(reason: "Use upc.")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "key-no-deprecated-fields") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag deprecated key fields", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Product @key(fields: "oldSku") @key(fields: "upc oldSku") {
+			upc: String!
+			oldSku: String! @deprecated(reason: "Use upc.")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Field `Product.oldSku` used in @key is deprecated; keys should reference stable fields."
+		if countRuleErrors(errors, "key-no-deprecated-fields") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should check leaf fields of nested key selections", func(t *testing.T) {
+		schema := federationDirectives + `
+		type Store {
+			id: ID!
+			legacyId: ID! @deprecated(reason: "Use id.")
+		}
+
+		type Product @key(fields: "store { legacyId }") {
+			store: Store!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Field `Store.legacyId` used in @key is deprecated; keys should reference stable fields."
+		if countRuleErrors(errors, "key-no-deprecated-fields") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// KeyShareableConflict checks that fields used in a @key are not also marked @shareable
+type KeyShareableConflict struct {
+	keyLint *KeyDirectivesLint
+}
+
+// NewKeyShareableConflict creates a new instance of the KeyShareableConflict rule
+func NewKeyShareableConflict() *KeyShareableConflict {
+	return &KeyShareableConflict{keyLint: NewKeyDirectivesLint()}
+}
+
+// Name returns the rule name
+func (r *KeyShareableConflict) Name() string {
+	return "key-shareable-conflict"
+}
+
+// Description returns what this rule checks
+func (r *KeyShareableConflict) Description() string {
+	return "Fields that are part of an entity's @key, including nested key fields of other entities, must not be marked @shareable on the field or its type"
+}
+
+// Check validates that no @key field is marked @shareable
+func (r *KeyShareableConflict) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	visitKeyFields(schema, r.keyLint, func(owner *ast.Definition, field *ast.FieldDefinition) {
+		// Nested key fields of value types are often shared on purpose
+		if owner.Directives.ForName("key") == nil {
+			return
+		}
+
+		var message string
+		switch {
+		case field.Directives.ForName("shareable") != nil:
+			message = fmt.Sprintf("Field `%s.%s` is part of the @key but is also marked @shareable, which is invalid.", owner.Name, field.Name)
+		case owner.Directives.ForName("shareable") != nil:
+			message = fmt.Sprintf("Field `%s.%s` is part of the @key but type `%s` is marked @shareable, which is invalid.", owner.Name, field.Name, owner.Name)
+		default:
+			return
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: message,
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	})
+
+	return errors
+}
@@ -0,0 +1,82 @@
+package rules
+
+import "testing"
+
+func TestKeyShareableConflict(t *testing.T) {
+	rule := NewKeyShareableConflict()
+
+	shareableDirective := `
+	directive @shareable on FIELD_DEFINITION | OBJECT
+	`
+
+	t.Run("should allow @shareable on fields outside the key", func(t *testing.T) {
+		schema := federationDirectives + shareableDirective + `
+		type User @key(fields: "id") {
+			id: ID!
+			name: String @shareable
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "key-shareable-conflict") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag @shareable key fields", func(t *testing.T) {
+		schema := federationDirectives + shareableDirective + `
+		type User @key(fields: "id") @key(fields: "id email") {
+			id: ID! @shareable
+			email: String! @shareable
+			name: String @shareable
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "key-shareable-conflict") != 2 {
+			t.Errorf("Expected 2 errors for shareable key fields, got %d", countRuleErrors(errors, "key-shareable-conflict"))
+		}
+
+		expectedMessage := "Field `User.id` is part of the @key but is also marked @shareable, which is invalid."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should treat a type-level @shareable as applying to key fields", func(t *testing.T) {
+		schema := federationDirectives + shareableDirective + `
+		type User @key(fields: "id") @shareable {
+			id: ID!
+			name: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Field `User.id` is part of the @key but type `User` is marked @shareable, which is invalid."
+		if countRuleErrors(errors, "key-shareable-conflict") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should check nested key fields of entities only", func(t *testing.T) {
+		schema := federationDirectives + shareableDirective + `
+		type Address @shareable {
+			zip: String!
+		}
+
+		type Org @key(fields: "id") {
+			id: ID! @shareable
+		}
+
+		type User @key(fields: "address { zip } org { id }") {
+			address: Address!
+			org: Org!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "key-shareable-conflict") != 1 {
+			t.Errorf("Expected 1 error, got %v", errors)
+		}
+		expectedMessage := "Field `Org.id` is part of the @key but is also marked @shareable, which is invalid."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+}
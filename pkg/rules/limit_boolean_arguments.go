@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultMaxBooleanArguments is the limit used by NewLimitBooleanArguments when none is configured
+const DefaultMaxBooleanArguments = 1
+
+// LimitBooleanArguments checks that fields don't accumulate boolean flag arguments
+type LimitBooleanArguments struct {
+	max int
+}
+
+// NewLimitBooleanArguments creates a new instance of the LimitBooleanArguments rule.
+// If max is less than 1, DefaultMaxBooleanArguments is used.
+func NewLimitBooleanArguments(max int) *LimitBooleanArguments {
+	if max < 1 {
+		max = DefaultMaxBooleanArguments
+	}
+	return &LimitBooleanArguments{max: max}
+}
+
+// Name returns the rule name
+func (r *LimitBooleanArguments) Name() string {
+	return "limit-boolean-arguments"
+}
+
+// Description returns what this rule checks
+func (r *LimitBooleanArguments) Description() string {
+	return fmt.Sprintf("Fields should have at most %d Boolean argument(s); use an enum or filter input for more toggles (opt-in)", r.max)
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *LimitBooleanArguments) OptIn() bool {
+	return true
+}
+
+// Check validates the number of Boolean arguments on each field
+func (r *LimitBooleanArguments) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			// Nullable and non-null booleans count alike; boolean lists are not flags
+			count := 0
+			for _, arg := range field.Arguments {
+				if arg.Type.NamedType == "Boolean" {
+					count++
+				}
+			}
+			if count <= r.max {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s.%s` has %d boolean arguments; consider an enum or filter input instead.", def.Name, field.Name, count),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,59 @@
+package rules
+
+import "testing"
+
+func TestLimitBooleanArguments(t *testing.T) {
+	rule := NewLimitBooleanArguments(0)
+
+	t.Run("should flag fields with too many boolean arguments", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type Query {
+			users(includeDeleted: Boolean, includeInactive: Boolean!, onlyAdmins: Boolean = false): [User!]!
+			user(id: ID!, includeDeleted: Boolean): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "limit-boolean-arguments") != 1 {
+			t.Errorf("Expected 1 error for too many boolean arguments, got %d", countRuleErrors(errors, "limit-boolean-arguments"))
+		}
+
+		expectedMessage := "Field `Query.users` has 3 boolean arguments; consider an enum or filter input instead."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should not count boolean lists", func(t *testing.T) {
+		schema := `
+		type Query {
+			flags(values: [Boolean!], strict: Boolean): [Boolean]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "limit-boolean-arguments") > 0 {
+			t.Error("Expected no errors when only one argument is a Boolean flag")
+		}
+	})
+
+	t.Run("should use a configured limit", func(t *testing.T) {
+		schema := `
+		type Query {
+			users(includeDeleted: Boolean, includeInactive: Boolean): String
+		}
+		`
+		errors := runRule(t, NewLimitBooleanArguments(2), schema)
+		if countRuleErrors(errors, "limit-boolean-arguments") > 0 {
+			t.Error("Expected no errors within the configured limit")
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected limit-boolean-arguments to be opt-in")
+		}
+	})
+}
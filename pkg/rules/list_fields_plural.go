@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// ListFieldsPlural checks that fields returning lists of objects have plural names
+type ListFieldsPlural struct {
+	plurals pluralDictionary
+}
+
+// NewListFieldsPlural creates a new instance of the ListFieldsPlural rule.
+// irregularPlurals maps lowercase singular words to their plurals; DefaultIrregularPlurals is used when empty.
+func NewListFieldsPlural(irregularPlurals map[string]string) *ListFieldsPlural {
+	return &ListFieldsPlural{plurals: newPluralDictionary(irregularPlurals, nil)}
+}
+
+// Name returns the rule name
+func (r *ListFieldsPlural) Name() string {
+	return "list-fields-plural"
+}
+
+// Description returns what this rule checks
+func (r *ListFieldsPlural) Description() string {
+	return "Fields returning a list of objects, interfaces or unions should have plural names. Connection fields are excluded"
+}
+
+// Check validates that list fields are named plurally
+func (r *ListFieldsPlural) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			if !isListType(field.Type) || !r.hasCompositeItems(schema, field.Type) {
+				continue
+			}
+
+			if r.plurals.isPlural(field.Name) || r.plurals.isUncountable(field.Name) || isCollectionName(field.Name) {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s.%s` returns a list but is named singularly; consider `%s`.", def.Name, field.Name, r.plurals.pluralize(field.Name)),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// hasCompositeItems checks if the innermost item type of a list is an object, interface or union
+// that is not a Relay connection. Scalar lists are checked by scalar-list-pluralization.
+func (r *ListFieldsPlural) hasCompositeItems(schema *ast.Schema, fieldType *ast.Type) bool {
+	itemType := schema.Types[fieldType.Name()]
+	if itemType == nil || strings.HasSuffix(itemType.Name, "Connection") {
+		return false
+	}
+	return itemType.Kind == ast.Object || itemType.Kind == ast.Interface || itemType.Kind == ast.Union
+}
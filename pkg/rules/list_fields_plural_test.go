@@ -0,0 +1,83 @@
+package rules
+
+import "testing"
+
+func TestListFieldsPlural(t *testing.T) {
+	t.Run("should allow plural list fields and connections", func(t *testing.T) {
+		rule := NewListFieldsPlural(nil)
+		schema := cursorPageInfo + `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			cursor: String!
+			node: User
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			pageInfo: PageInfo!
+		}
+
+		type Query {
+			users: [User!]!
+			children: [User!]
+			userList: [User!]
+			friendGroup: [UserConnection!]
+			tag: [String!]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "list-fields-plural") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag singular list fields", func(t *testing.T) {
+		rule := NewListFieldsPlural(nil)
+		schema := `
+		type User {
+			id: ID!
+			bestChild: [User!]
+		}
+
+		type Query {
+			user: [User!]!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "list-fields-plural") != 2 {
+			t.Errorf("Expected 2 errors for singular list fields, got %d", countRuleErrors(errors, "list-fields-plural"))
+		}
+
+		expectedMessages := []string{
+			"Field `Query.user` returns a list but is named singularly; consider `users`.",
+			"Field `User.bestChild` returns a list but is named singularly; consider `bestChildren`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should use configured irregular plurals", func(t *testing.T) {
+		rule := NewListFieldsPlural(map[string]string{"cactus": "cacti"})
+		schema := `
+		type Plant {
+			id: ID!
+		}
+
+		type Query {
+			cacti: [Plant!]
+			cactus: [Plant!]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Field `Query.cactus` returns a list but is named singularly; consider `cacti`."
+		if countRuleErrors(errors, "list-fields-plural") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
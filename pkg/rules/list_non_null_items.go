@@ -105,7 +105,8 @@ func (r *ListNonNullItems) suggestNonNullVariant(fieldType *ast.Type) string {
 	return correctedType.String()
 }
 
-// makeListItemsNonNull creates a copy of the type with all list items marked as non-null
+// makeListItemsNonNull creates a copy of the type with all list items marked as non-null.
+// The schema is shared between rules, so the original type must never be modified.
 func (r *ListNonNullItems) makeListItemsNonNull(fieldType *ast.Type) *ast.Type {
 	// Handle the outer non-null wrapper
 	if fieldType.Elem != nil {
@@ -115,10 +116,11 @@ func (r *ListNonNullItems) makeListItemsNonNull(fieldType *ast.Type) *ast.Type {
 		}
 	}
 
-	fieldType.NonNull = true
-
 	// Base case: named type
-	return fieldType
+	return &ast.Type{
+		NamedType: fieldType.NamedType,
+		NonNull:   true,
+	}
 }
 
 // isConnectionType checks if a type name indicates a connection type
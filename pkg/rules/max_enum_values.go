@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultMaxEnumValues is the limit used by NewMaxEnumValues when none is configured
+const DefaultMaxEnumValues = 100
+
+// MaxEnumValues checks that enums don't grow past a maximum number of values
+type MaxEnumValues struct {
+	limit int
+}
+
+// NewMaxEnumValues creates a new instance of the MaxEnumValues rule.
+// If limit is less than 1, DefaultMaxEnumValues is used.
+func NewMaxEnumValues(limit int) *MaxEnumValues {
+	if limit < 1 {
+		limit = DefaultMaxEnumValues
+	}
+	return &MaxEnumValues{limit: limit}
+}
+
+// Name returns the rule name
+func (r *MaxEnumValues) Name() string {
+	return "max-enum-values"
+}
+
+// Description returns what this rule checks
+func (r *MaxEnumValues) Description() string {
+	return fmt.Sprintf("Enums should have at most %d values; larger sets are better modeled as a scalar or lookup type", r.limit)
+}
+
+// Check validates the number of values in each enum
+func (r *MaxEnumValues) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Enum || len(def.EnumValues) <= r.limit {
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Enum `%s` has %d values, exceeding the maximum of %d; consider a scalar or lookup type.", def.Name, len(def.EnumValues), r.limit),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
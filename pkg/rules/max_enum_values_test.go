@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// enumWithValues builds an enum definition with the given number of values
+func enumWithValues(name string, count int) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "enum %s {\n", name)
+	for i := 0; i < count; i++ {
+		fmt.Fprintf(&builder, "\tVALUE_%d\n", i)
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func TestMaxEnumValues(t *testing.T) {
+	t.Run("should allow enums up to the default limit", func(t *testing.T) {
+		rule := NewMaxEnumValues(0)
+		schema := enumWithValues("CountryCode", DefaultMaxEnumValues) + `
+		type Query {
+			country: CountryCode
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "max-enum-values") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag enums over the default limit", func(t *testing.T) {
+		rule := NewMaxEnumValues(0)
+		schema := enumWithValues("CountryCode", 249) + `
+		type Query {
+			country: CountryCode
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Enum `CountryCode` has 249 values, exceeding the maximum of 100; consider a scalar or lookup type."
+		if countRuleErrors(errors, "max-enum-values") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should use a configured limit", func(t *testing.T) {
+		rule := NewMaxEnumValues(3)
+		schema := enumWithValues("Size", 3) + enumWithValues("Color", 4) + `
+		type Query {
+			size: Size
+			color: Color
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Enum `Color` has 4 values, exceeding the maximum of 3; consider a scalar or lookup type."
+		if countRuleErrors(errors, "max-enum-values") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
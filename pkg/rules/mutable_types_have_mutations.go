@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultAuditFields are the field names that mark a type as mutable when none are configured
+var DefaultAuditFields = []string{"updatedAt"}
+
+// MutableTypesHaveMutations checks that types tracking modification times are modified by at least one mutation
+type MutableTypesHaveMutations struct {
+	auditFields []string
+}
+
+// NewMutableTypesHaveMutations creates a new instance of the MutableTypesHaveMutations rule.
+// If auditFields is empty, DefaultAuditFields is used.
+func NewMutableTypesHaveMutations(auditFields []string) *MutableTypesHaveMutations {
+	if len(auditFields) == 0 {
+		auditFields = DefaultAuditFields
+	}
+	return &MutableTypesHaveMutations{auditFields: auditFields}
+}
+
+// Name returns the rule name
+func (r *MutableTypesHaveMutations) Name() string {
+	return "mutable-types-have-mutations"
+}
+
+// Description returns what this rule checks
+func (r *MutableTypesHaveMutations) Description() string {
+	return "Types with audit fields like updatedAt should be the subject of at least one mutation, otherwise the audit field is misleading (opt-in, informational)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *MutableTypesHaveMutations) OptIn() bool {
+	return true
+}
+
+// Check validates that every type with an audit field is modified by a mutation
+func (r *MutableTypesHaveMutations) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	subjects := r.findMutationSubjects(schema)
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") || def.Kind != ast.Object {
+			continue
+		}
+		if def == schema.Query || def == schema.Mutation || def == schema.Subscription {
+			continue
+		}
+
+		auditField := r.findAuditField(def)
+		if auditField == nil || r.isMutationSubject(def.Name, subjects, schema) {
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Type `%s` tracks `%s` but no mutation modifies it.", def.Name, auditField.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// findAuditField returns the first configured audit field declared by the type
+func (r *MutableTypesHaveMutations) findAuditField(def *ast.Definition) *ast.FieldDefinition {
+	for _, name := range r.auditFields {
+		if field := def.Fields.ForName(name); field != nil {
+			return field
+		}
+	}
+	return nil
+}
+
+// findMutationSubjects collects the types referenced by mutation payloads, one level deep
+func (r *MutableTypesHaveMutations) findMutationSubjects(schema *ast.Schema) map[string]bool {
+	subjects := make(map[string]bool)
+	if schema.Mutation == nil {
+		return subjects
+	}
+
+	for _, field := range schema.Mutation.Fields {
+		returnType := schema.Types[field.Type.Name()]
+		if returnType == nil {
+			continue
+		}
+
+		// The payload itself, or every member of a payload union/interface
+		payloads := []*ast.Definition{returnType}
+		if returnType.Kind == ast.Union || returnType.Kind == ast.Interface {
+			payloads = schema.GetPossibleTypes(returnType)
+		}
+
+		for _, payload := range payloads {
+			subjects[payload.Name] = true
+			for _, payloadField := range payload.Fields {
+				subjects[payloadField.Type.Name()] = true
+			}
+		}
+	}
+
+	return subjects
+}
+
+// isMutationSubject checks if a type is referenced by a mutation payload, or named by a mutation or its argument types
+func (r *MutableTypesHaveMutations) isMutationSubject(typeName string, subjects map[string]bool, schema *ast.Schema) bool {
+	if subjects[typeName] {
+		return true
+	}
+	if schema.Mutation == nil {
+		return false
+	}
+
+	for _, field := range schema.Mutation.Fields {
+		if strings.Contains(field.Name, typeName) {
+			return true
+		}
+		for _, arg := range field.Arguments {
+			if strings.Contains(arg.Type.Name(), typeName) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
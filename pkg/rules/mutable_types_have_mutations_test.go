@@ -0,0 +1,103 @@
+package rules
+
+import "testing"
+
+func TestMutableTypesHaveMutations(t *testing.T) {
+	rule := NewMutableTypesHaveMutations(nil)
+
+	if !rule.OptIn() {
+		t.Error("Expected mutable-types-have-mutations to be opt-in")
+	}
+
+	t.Run("should flag audited types that no mutation modifies", func(t *testing.T) {
+		schema := `
+		type Post {
+			id: ID!
+			updatedAt: String
+		}
+
+		type User {
+			id: ID!
+			updatedAt: String
+		}
+
+		type Query {
+			post(id: ID!): Post
+			user(id: ID!): User
+		}
+
+		type Mutation {
+			renameUser(id: ID!, name: String!): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "mutable-types-have-mutations") != 1 {
+			t.Errorf("Expected 1 error, got %d", countRuleErrors(errors, "mutable-types-have-mutations"))
+		}
+
+		expectedMessage := "Type `Post` tracks `updatedAt` but no mutation modifies it."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should recognize payload, name and input references", func(t *testing.T) {
+		schema := `
+		type Post {
+			id: ID!
+			updatedAt: String
+		}
+
+		type Comment {
+			id: ID!
+			updatedAt: String
+		}
+
+		type Tag {
+			id: ID!
+			updatedAt: String
+		}
+
+		type EditPostPayload {
+			post: Post
+		}
+
+		input TagChanges {
+			name: String
+		}
+
+		type Query {
+			post(id: ID!): Post
+			comment(id: ID!): Comment
+			tag(id: ID!): Tag
+		}
+
+		type Mutation {
+			editPost(id: ID!): EditPostPayload
+			deleteComment(id: ID!): Boolean
+			tagging(changes: TagChanges!): Boolean
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "mutable-types-have-mutations") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "mutable-types-have-mutations"))
+		}
+	})
+
+	t.Run("should use configured audit fields", func(t *testing.T) {
+		schema := `
+		type Post {
+			id: ID!
+			modifiedAt: String
+		}
+
+		type Query {
+			post(id: ID!): Post
+		}
+		`
+		errors := runRule(t, NewMutableTypesHaveMutations([]string{"modifiedAt"}), schema)
+		if !containsError(errors, "Type `Post` tracks `modifiedAt` but no mutation modifies it.") {
+			t.Error("Expected Post to be flagged for the configured audit field")
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// MutationNoQueryReturn checks that mutation fields don't return the root Query type
+type MutationNoQueryReturn struct {
+	allowRequery bool
+}
+
+// NewMutationNoQueryReturn creates a new instance of the MutationNoQueryReturn rule.
+// When allowRequery is true, mutations may return the root Query type so clients can re-query.
+func NewMutationNoQueryReturn(allowRequery bool) *MutationNoQueryReturn {
+	return &MutationNoQueryReturn{allowRequery: allowRequery}
+}
+
+// Name returns the rule name
+func (r *MutationNoQueryReturn) Name() string {
+	return "mutation-no-query-return"
+}
+
+// Description returns what this rule checks
+func (r *MutationNoQueryReturn) Description() string {
+	return "Mutation fields should not return the root Query type for re-querying; return the affected entity or a payload instead"
+}
+
+// Check validates that no mutation field returns the root Query type
+func (r *MutationNoQueryReturn) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	if r.allowRequery || schema.Mutation == nil || schema.Query == nil {
+		return errors
+	}
+
+	for _, field := range schema.Mutation.Fields {
+		// Skip introspection fields
+		if strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+
+		if field.Type.Name() != schema.Query.Name {
+			continue
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Mutation `%s` returns the root `%s` type; return the affected entity or a payload instead.", field.Name, schema.Query.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
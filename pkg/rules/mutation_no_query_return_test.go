@@ -0,0 +1,84 @@
+package rules
+
+import "testing"
+
+func TestMutationNoQueryReturn(t *testing.T) {
+	rule := NewMutationNoQueryReturn(false)
+
+	t.Run("should flag mutations returning the root Query type", func(t *testing.T) {
+		schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			id: ID!
+		}
+
+		type Mutation {
+			refresh: Query
+			refreshAll: [Query!]
+			updateUser(id: ID!): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "mutation-no-query-return") != 2 {
+			t.Errorf("Expected 2 errors for mutations returning Query, got %d", countRuleErrors(errors, "mutation-no-query-return"))
+		}
+
+		expectedMessage := "Mutation `refresh` returns the root `Query` type; return the affected entity or a payload instead."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should use the schema's declared query root name", func(t *testing.T) {
+		schema := `
+		schema {
+			query: RootQuery
+			mutation: RootMutation
+		}
+
+		type RootQuery {
+			version: String
+		}
+
+		type RootMutation {
+			refresh: RootQuery
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Mutation `refresh` returns the root `RootQuery` type; return the affected entity or a payload instead."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should allow the re-query pattern when configured", func(t *testing.T) {
+		schema := `
+		type Query {
+			version: String
+		}
+
+		type Mutation {
+			refresh: Query
+		}
+		`
+		errors := runRule(t, NewMutationNoQueryReturn(true), schema)
+		if countRuleErrors(errors, "mutation-no-query-return") > 0 {
+			t.Error("Expected no errors when re-query pattern is allowed")
+		}
+	})
+
+	t.Run("should pass when there is no Mutation type", func(t *testing.T) {
+		schema := `
+		type Query {
+			version: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "mutation-no-query-return") > 0 {
+			t.Error("Expected no errors without a Mutation type")
+		}
+	})
+}
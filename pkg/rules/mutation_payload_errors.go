@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultPayloadErrorFieldNames are the error list field names accepted by NewMutationPayloadErrors when none are configured
+var DefaultPayloadErrorFieldNames = []string{"userErrors", "errors"}
+
+// MutationPayloadErrors checks that mutation payload types expose a list of errors
+type MutationPayloadErrors struct {
+	fieldNames   []string
+	mutationLint *MutationLint
+}
+
+// NewMutationPayloadErrors creates a new instance of the MutationPayloadErrors rule.
+// If fieldNames is empty, DefaultPayloadErrorFieldNames is used.
+func NewMutationPayloadErrors(fieldNames []string) *MutationPayloadErrors {
+	if len(fieldNames) == 0 {
+		fieldNames = DefaultPayloadErrorFieldNames
+	}
+	return &MutationPayloadErrors{fieldNames: fieldNames, mutationLint: NewMutationLint()}
+}
+
+// Name returns the rule name
+func (r *MutationPayloadErrors) Name() string {
+	return "mutation-payload-errors"
+}
+
+// Description returns what this rule checks
+func (r *MutationPayloadErrors) Description() string {
+	return fmt.Sprintf("Mutation payload object types should have a `%s` field returning a list of error types", strings.Join(r.fieldNames, "` or `"))
+}
+
+// Check validates that mutation payloads expose an error list
+func (r *MutationPayloadErrors) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	if schema.Mutation == nil {
+		return errors
+	}
+
+	checked := make(map[string]bool)
+	for _, mutation := range schema.Mutation.Fields {
+		// Skip introspection fields
+		if strings.HasPrefix(mutation.Name, "__") {
+			continue
+		}
+
+		// Only object payloads are checked; unions carry errors as members
+		payload := schema.Types[mutation.Type.Name()]
+		if payload == nil || payload.Kind != ast.Object || r.mutationLint.hasResponseUnionDirective(payload) || checked[payload.Name] {
+			continue
+		}
+		checked[payload.Name] = true
+
+		var errorsField *ast.FieldDefinition
+		for _, name := range r.fieldNames {
+			if errorsField = payload.Fields.ForName(name); errorsField != nil {
+				break
+			}
+		}
+
+		var message string
+		position := payload.Position
+		switch {
+		case errorsField == nil:
+			message = fmt.Sprintf("Mutation payload `%s` is missing a `%s` field for surfacing validation errors.", payload.Name, r.fieldNames[0])
+		case !isListType(errorsField.Type) || !r.isErrorType(schema.Types[errorsField.Type.Name()]):
+			message = fmt.Sprintf("Mutation payload `%s` field `%s` should return a list of error types, but returns `%s`.",
+				payload.Name, errorsField.Name, errorsField.Type.String())
+			position = errorsField.Position
+		default:
+			continue
+		}
+
+		line, column := 1, 1
+		if position != nil {
+			line = position.Line
+			column = position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: message,
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// isErrorType checks if a type describes errors: it is marked @error or is a composite type named `*Error`
+func (r *MutationPayloadErrors) isErrorType(def *ast.Definition) bool {
+	if def == nil {
+		return false
+	}
+	if r.mutationLint.hasErrorDirective(def) {
+		return true
+	}
+	isComposite := def.Kind == ast.Object || def.Kind == ast.Interface || def.Kind == ast.Union
+	return isComposite && strings.HasSuffix(def.Name, "Error")
+}
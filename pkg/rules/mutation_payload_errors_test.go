@@ -0,0 +1,124 @@
+package rules
+
+import "testing"
+
+func TestMutationPayloadErrors(t *testing.T) {
+	t.Run("should allow payloads with an error list", func(t *testing.T) {
+		rule := NewMutationPayloadErrors(nil)
+		schema := `
+		directive @error on OBJECT
+
+		type User {
+			id: ID!
+		}
+
+		type UserError {
+			message: String!
+		}
+
+		type EmailTaken @error {
+			code: String!
+			message: String!
+		}
+
+		type CreateUserPayload {
+			user: User
+			userErrors: [UserError!]!
+		}
+
+		type InviteUserPayload {
+			user: User
+			errors: [EmailTaken!]
+		}
+
+		union DeleteUserResult = User | EmailTaken
+
+		type Query {
+			user: User
+		}
+
+		type Mutation {
+			createUser(name: String!): CreateUserPayload
+			inviteUser(email: String!): InviteUserPayload
+			deleteUser(id: ID!): DeleteUserResult
+			ping: Boolean
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "mutation-payload-errors") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag payloads without a valid error list", func(t *testing.T) {
+		rule := NewMutationPayloadErrors(nil)
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type CreateUserPayload {
+			user: User
+		}
+
+		type UpdateUserPayload {
+			user: User
+			userErrors: [String!]!
+		}
+
+		type Query {
+			user: User
+		}
+
+		type Mutation {
+			createUser(name: String!): CreateUserPayload
+			importUser(name: String!): CreateUserPayload
+			updateUser(id: ID!): UpdateUserPayload
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Mutation payload `CreateUserPayload` is missing a `userErrors` field for surfacing validation errors.",
+			"Mutation payload `UpdateUserPayload` field `userErrors` should return a list of error types, but returns `[String!]!`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "mutation-payload-errors") != 2 {
+			t.Errorf("Expected 2 errors, got %v", errors)
+		}
+	})
+
+	t.Run("should use the configured field names", func(t *testing.T) {
+		rule := NewMutationPayloadErrors([]string{"problems"})
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type UserError {
+			message: String!
+		}
+
+		type CreateUserPayload {
+			user: User
+			userErrors: [UserError!]!
+		}
+
+		type Query {
+			user: User
+		}
+
+		type Mutation {
+			createUser(name: String!): CreateUserPayload
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Mutation payload `CreateUserPayload` is missing a `problems` field for surfacing validation errors."
+		if countRuleErrors(errors, "mutation-payload-errors") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
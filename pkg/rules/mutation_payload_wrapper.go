@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// payloadTypeSuffixes are the name suffixes of types that wrap a mutation's result
+var payloadTypeSuffixes = []string{"Payload", "Result"}
+
+// MutationPayloadWrapper checks that mutations return a payload type instead of the mutated entity
+type MutationPayloadWrapper struct {
+	mutationLint *MutationLint
+}
+
+// NewMutationPayloadWrapper creates a new instance of the MutationPayloadWrapper rule
+func NewMutationPayloadWrapper() *MutationPayloadWrapper {
+	return &MutationPayloadWrapper{mutationLint: NewMutationLint()}
+}
+
+// Name returns the rule name
+func (r *MutationPayloadWrapper) Name() string {
+	return "mutation-payload-wrapper"
+}
+
+// Description returns what this rule checks
+func (r *MutationPayloadWrapper) Description() string {
+	return "Mutations should return a payload type (named `*Payload` or `*Result`, or a union) rather than the mutated entity"
+}
+
+// Check validates that mutation fields return payload types
+func (r *MutationPayloadWrapper) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	if schema.Mutation == nil {
+		return errors
+	}
+
+	for _, field := range schema.Mutation.Fields {
+		// Skip introspection fields
+		if strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+
+		// Scalars and enums are left to no-scalar-result-type-on-mutation
+		returnType := schema.Types[field.Type.Name()]
+		if returnType == nil || (returnType.Kind != ast.Object && returnType.Kind != ast.Interface) {
+			continue
+		}
+		if r.isPayloadType(returnType) {
+			continue
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Mutation `%s` returns `%s` directly; wrap the result in a `%sPayload` type.",
+				field.Name, returnType.Name, strings.ToUpper(field.Name[:1])+field.Name[1:]),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// isPayloadType checks if a type is named like a payload or is a @responseUnion virtual union
+func (r *MutationPayloadWrapper) isPayloadType(def *ast.Definition) bool {
+	for _, suffix := range payloadTypeSuffixes {
+		if strings.HasSuffix(def.Name, suffix) {
+			return true
+		}
+	}
+	return r.mutationLint.hasResponseUnionDirective(def)
+}
@@ -0,0 +1,78 @@
+package rules
+
+import "testing"
+
+func TestMutationPayloadWrapper(t *testing.T) {
+	rule := NewMutationPayloadWrapper()
+
+	t.Run("should allow payload types and unions", func(t *testing.T) {
+		schema := `
+		directive @responseUnion on UNION | OBJECT
+		directive @error on OBJECT
+
+		type User {
+			id: ID!
+		}
+
+		type CreateUserPayload {
+			user: User
+		}
+
+		type DeleteUserResult {
+			success: Boolean!
+		}
+
+		type UserNotFound @error {
+			code: String!
+			message: String!
+		}
+
+		union UpdateUserResponse = User | UserNotFound
+
+		type Query {
+			user: User
+		}
+
+		type Mutation {
+			createUser(name: String!): CreateUserPayload
+			deleteUser(id: ID!): DeleteUserResult
+			updateUser(id: ID!): UpdateUserResponse
+			ping: Boolean
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "mutation-payload-wrapper") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag mutations returning the entity directly", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type Query {
+			user: User
+		}
+
+		type Mutation {
+			createUser(name: String!): User!
+			importUsers(names: [String!]!): [User!]!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Mutation `createUser` returns `User` directly; wrap the result in a `CreateUserPayload` type.",
+			"Mutation `importUsers` returns `User` directly; wrap the result in a `ImportUsersPayload` type.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "mutation-payload-wrapper") != 2 {
+			t.Errorf("Expected 2 errors, got %v", errors)
+		}
+	})
+}
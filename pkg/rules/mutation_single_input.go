@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// MutationSingleInput checks that mutations take a single input object argument
+type MutationSingleInput struct{}
+
+// NewMutationSingleInput creates a new instance of the MutationSingleInput rule
+func NewMutationSingleInput() *MutationSingleInput {
+	return &MutationSingleInput{}
+}
+
+// Name returns the rule name
+func (r *MutationSingleInput) Name() string {
+	return "mutation-single-input"
+}
+
+// Description returns what this rule checks
+func (r *MutationSingleInput) Description() string {
+	return "Every Mutation field should take exactly one argument whose type is an input object. Argument and input type names are checked by operation-input-name"
+}
+
+// Check validates that every mutation takes a single input object argument
+func (r *MutationSingleInput) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	if schema.Mutation == nil {
+		return errors
+	}
+
+	for _, field := range schema.Mutation.Fields {
+		// Skip introspection fields
+		if strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+
+		if len(field.Arguments) == 1 && r.isInputObject(schema, field.Arguments[0].Type) {
+			continue
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Mutation `%s` should take a single input object argument, but takes %s.", field.Name, r.describeArguments(schema, field.Arguments)),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// isInputObject checks if a type is a single, non-list input object
+func (r *MutationSingleInput) isInputObject(schema *ast.Schema, argType *ast.Type) bool {
+	if isListType(argType) {
+		return false
+	}
+	def := schema.Types[argType.Name()]
+	return def != nil && def.Kind == ast.InputObject
+}
+
+// describeArguments describes an argument list, e.g. "no arguments" or "3 scalar arguments"
+func (r *MutationSingleInput) describeArguments(schema *ast.Schema, args ast.ArgumentDefinitionList) string {
+	if len(args) == 0 {
+		return "no arguments"
+	}
+
+	// Call the arguments scalar when every one of them is a leaf type
+	kind := "scalar "
+	for _, arg := range args {
+		def := schema.Types[arg.Type.Name()]
+		if def == nil || (def.Kind != ast.Scalar && def.Kind != ast.Enum) {
+			kind = ""
+			break
+		}
+	}
+
+	if len(args) == 1 {
+		return fmt.Sprintf("1 %sargument", kind)
+	}
+	return fmt.Sprintf("%d %sarguments", len(args), kind)
+}
@@ -0,0 +1,70 @@
+package rules
+
+import "testing"
+
+func TestMutationSingleInput(t *testing.T) {
+	rule := NewMutationSingleInput()
+
+	t.Run("should allow a single input object argument", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		input CreateUserInput {
+			name: String!
+		}
+
+		type Query {
+			user(id: ID!, name: String): User
+		}
+
+		type Mutation {
+			createUser(input: CreateUserInput!): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "mutation-single-input") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag mutations without a single input object argument", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		input UserInput {
+			name: String!
+		}
+
+		type Query {
+			user: User
+		}
+
+		type Mutation {
+			createUser(name: String!, email: String!, age: Int): User
+			deleteUser(id: ID!): User
+			importUsers(input: [UserInput!]!): User
+			logout: Boolean
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "mutation-single-input") != 4 {
+			t.Errorf("Expected 4 errors, got %d", countRuleErrors(errors, "mutation-single-input"))
+		}
+
+		expectedMessages := []string{
+			"Mutation `createUser` should take a single input object argument, but takes 3 scalar arguments.",
+			"Mutation `deleteUser` should take a single input object argument, but takes 1 scalar argument.",
+			"Mutation `importUsers` should take a single input object argument, but takes 1 argument.",
+			"Mutation `logout` should take a single input object argument, but takes no arguments.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+}
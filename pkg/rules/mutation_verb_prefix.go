@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultMutationVerbs are the verbs accepted by NewMutationVerbPrefix when no list is configured
+var DefaultMutationVerbs = []string{
+	"update", "create", "delete", "add", "remove", "set", "unset", "publish", "unpublish",
+	"archive", "unarchive", "assign", "unassign", "cancel", "approve", "reject", "submit",
+	"send", "start", "stop", "enable", "disable", "register", "reset", "upload", "upsert",
+	"link", "unlink", "move", "copy", "mark", "request", "confirm", "verify", "invite",
+	"accept", "decline", "complete", "close", "open", "restore", "sync", "toggle",
+}
+
+// MutationVerbPrefix checks that mutation field names start with a verb
+type MutationVerbPrefix struct {
+	verbs []string
+}
+
+// NewMutationVerbPrefix creates a new instance of the MutationVerbPrefix rule.
+// If verbs is empty, DefaultMutationVerbs is used.
+func NewMutationVerbPrefix(verbs []string) *MutationVerbPrefix {
+	if len(verbs) == 0 {
+		verbs = DefaultMutationVerbs
+	}
+	return &MutationVerbPrefix{verbs: verbs}
+}
+
+// Name returns the rule name
+func (r *MutationVerbPrefix) Name() string {
+	return "mutation-verb-prefix"
+}
+
+// Description returns what this rule checks
+func (r *MutationVerbPrefix) Description() string {
+	return "Mutation field names should start with a verb (create, update, delete, ...) to keep mutations action-oriented"
+}
+
+// Check validates that every mutation field starts with a recognized verb
+func (r *MutationVerbPrefix) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	if schema.Mutation == nil {
+		return errors
+	}
+
+	for _, field := range schema.Mutation.Fields {
+		// Skip introspection fields
+		if strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+
+		if r.startsWithVerb(field.Name) {
+			continue
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Mutation field `%s` should start with a verb like `%s`.", field.Name, r.suggestVerb(field.Name)),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// startsWithVerb checks if the name begins with a configured verb as a whole camelCase word
+func (r *MutationVerbPrefix) startsWithVerb(name string) bool {
+	for _, verb := range r.verbs {
+		if !strings.HasPrefix(name, verb) {
+			continue
+		}
+		rest := name[len(verb):]
+		if rest == "" || !unicode.IsLower(rune(rest[0])) {
+			return true
+		}
+	}
+	return false
+}
+
+// suggestVerb picks a verb already used later in the name (e.g. `profileUpdate`), falling back to the first configured verb
+func (r *MutationVerbPrefix) suggestVerb(name string) string {
+	for _, verb := range r.verbs {
+		if len(verb) > 0 && strings.Contains(name, strings.ToUpper(verb[:1])+verb[1:]) {
+			return verb
+		}
+	}
+	return r.verbs[0]
+}
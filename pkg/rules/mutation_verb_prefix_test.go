@@ -0,0 +1,87 @@
+package rules
+
+import "testing"
+
+func TestMutationVerbPrefix(t *testing.T) {
+	rule := NewMutationVerbPrefix(nil)
+
+	t.Run("should flag mutation fields that don't start with a verb", func(t *testing.T) {
+		schema := `
+		type Query {
+			version: String
+		}
+
+		type Mutation {
+			userProfile(id: ID!): String
+			profileUpdate(id: ID!): String
+			settings: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "mutation-verb-prefix") != 3 {
+			t.Errorf("Expected 3 errors for mutations without a verb prefix, got %d", countRuleErrors(errors, "mutation-verb-prefix"))
+		}
+
+		expectedMessages := []string{
+			"Mutation field `userProfile` should start with a verb like `update`.",
+			"Mutation field `profileUpdate` should start with a verb like `update`.",
+			"Mutation field `settings` should start with a verb like `update`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should pass mutation fields that start with a verb", func(t *testing.T) {
+		schema := `
+		type Query {
+			version: String
+		}
+
+		type Mutation {
+			createUser(name: String!): String
+			deleteUser(id: ID!): String
+			publish(id: ID!): String
+			setUserRole(id: ID!): String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "mutation-verb-prefix") > 0 {
+			t.Errorf("Expected no errors for verb-prefixed mutations, got %d", countRuleErrors(errors, "mutation-verb-prefix"))
+		}
+	})
+
+	t.Run("should use a configured verb list", func(t *testing.T) {
+		schema := `
+		type Query {
+			version: String
+		}
+
+		type Mutation {
+			launchRocket: String
+			createRocket: String
+		}
+		`
+		errors := runRule(t, NewMutationVerbPrefix([]string{"launch"}), schema)
+		if countRuleErrors(errors, "mutation-verb-prefix") != 1 {
+			t.Errorf("Expected 1 error with a custom verb list, got %d", countRuleErrors(errors, "mutation-verb-prefix"))
+		}
+		if !containsError(errors, "Mutation field `createRocket` should start with a verb like `launch`.") {
+			t.Error("Expected createRocket to be flagged with the configured verb")
+		}
+	})
+
+	t.Run("should pass when there is no Mutation type", func(t *testing.T) {
+		schema := `
+		type Query {
+			version: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "mutation-verb-prefix") > 0 {
+			t.Error("Expected no errors without a Mutation type")
+		}
+	})
+}
@@ -37,22 +37,14 @@ func (r *NamingConvention) Check(schema *ast.Schema, source *ast.Source) []types
 			continue
 		}
 
-		line, column := 1, 1
-		if def.Position != nil {
-			line = def.Position.Line
-			column = def.Position.Column
-		}
+		location := nameLocation(source, def.Position, def.Name)
 
 		// Check that type names are PascalCase
 		if !r.isPascalCase(def.Name) {
 			errors = append(errors, types.LintError{
-				Message: fmt.Sprintf("Type name `%s` should be PascalCase.", def.Name),
-				Location: types.Location{
-					Line:   line,
-					Column: column,
-					File:   source.Name,
-				},
-				Rule: r.Name(),
+				Message:  fmt.Sprintf("Type name `%s` should be PascalCase.", def.Name),
+				Location: location,
+				Rule:     r.Name(),
 			})
 		}
 		//Type / Object
@@ -61,25 +53,17 @@ func (r *NamingConvention) Check(schema *ast.Schema, source *ast.Source) []types
 			strings.HasPrefix(def.Name, "Type") ||
 			strings.HasPrefix(def.Name, "Object")) {
 			errors = append(errors, types.LintError{
-				Message: fmt.Sprintf("Type name `%s` should be PascalCase and should not start/end with `Type` or `Object`", def.Name),
-				Location: types.Location{
-					Line:   line,
-					Column: column,
-					File:   source.Name,
-				},
-				Rule: r.Name(),
+				Message:  fmt.Sprintf("Type name `%s` should be PascalCase and should not start/end with `Type` or `Object`", def.Name),
+				Location: location,
+				Rule:     r.Name(),
 			})
 		}
 
 		if def.Kind == ast.Interface && (strings.HasSuffix(def.Name, "Interface") || strings.HasPrefix(def.Name, "Interface")) {
 			errors = append(errors, types.LintError{
-				Message: fmt.Sprintf("Interface name `%s` should be PascalCase and should not start/end with `Interface`", def.Name),
-				Location: types.Location{
-					Line:   line,
-					Column: column,
-					File:   source.Name,
-				},
-				Rule: r.Name(),
+				Message:  fmt.Sprintf("Interface name `%s` should be PascalCase and should not start/end with `Interface`", def.Name),
+				Location: location,
+				Rule:     r.Name(),
 			})
 		}
 	}
@@ -88,42 +72,26 @@ func (r *NamingConvention) Check(schema *ast.Schema, source *ast.Source) []types
 	for _, def := range schema.Types {
 		if def.Kind == ast.Enum {
 			// Check enum type name doesn't start/end with "Enum"
-			line, column := 1, 1
-			if def.Position != nil {
-				line = def.Position.Line
-				column = def.Position.Column
-			}
+			location := nameLocation(source, def.Position, def.Name)
 
 			if strings.HasSuffix(strings.ToLower(def.Name), "enum") ||
 				strings.HasPrefix(strings.ToLower(def.Name), "enum") {
 				errors = append(errors, types.LintError{
-					Message: fmt.Sprintf("Enum name `%s` should not start or end with `Enum`", def.Name),
-					Location: types.Location{
-						Line:   line,
-						Column: column,
-						File:   source.Name,
-					},
-					Rule: r.Name(),
+					Message:  fmt.Sprintf("Enum name `%s` should not start or end with `Enum`", def.Name),
+					Location: location,
+					Rule:     r.Name(),
 				})
 			}
 
 			// Check enum values are UPPER_CASE
 			for _, value := range def.EnumValues {
-				valueLine, valueColumn := 1, 1
-				if value.Position != nil {
-					valueLine = value.Position.Line
-					valueColumn = value.Position.Column
-				}
+				valueLocation := nameLocation(source, value.Position, value.Name)
 
 				if !r.isUpperCase(value.Name) {
 					errors = append(errors, types.LintError{
-						Message: fmt.Sprintf("Enum value `%s.%s` should be UPPER_CASE", def.Name, value.Name),
-						Location: types.Location{
-							Line:   valueLine,
-							Column: valueColumn,
-							File:   source.Name,
-						},
-						Rule: r.Name(),
+						Message:  fmt.Sprintf("Enum value `%s.%s` should be UPPER_CASE", def.Name, value.Name),
+						Location: valueLocation,
+						Rule:     r.Name(),
 					})
 				}
 			}
@@ -135,22 +103,14 @@ func (r *NamingConvention) Check(schema *ast.Schema, source *ast.Source) []types
 					continue
 				}
 
-				line, column := 1, 1
-				if field.Position != nil {
-					line = field.Position.Line
-					column = field.Position.Column
-				}
+				location := nameLocation(source, field.Position, field.Name)
 
 				// Check that field names are camelCase
 				if !r.isCamelCase(field.Name) {
 					errors = append(errors, types.LintError{
-						Message: fmt.Sprintf("Field name `%s.%s` should be camelCase.", def.Name, field.Name),
-						Location: types.Location{
-							Line:   line,
-							Column: column,
-							File:   source.Name,
-						},
-						Rule: r.Name(),
+						Message:  fmt.Sprintf("Field name `%s.%s` should be camelCase.", def.Name, field.Name),
+						Location: location,
+						Rule:     r.Name(),
 					})
 				}
 			}
@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultAbbreviations maps banned abbreviations to the words they should be spelled out as
+var DefaultAbbreviations = map[string]string{
+	"acct": "account",
+	"addr": "address",
+	"amt":  "amount",
+	"cfg":  "config",
+	"cnt":  "count",
+	"img":  "image",
+	"msg":  "message",
+	"num":  "number",
+	"pwd":  "password",
+	"qty":  "quantity",
+	"tmp":  "temporary",
+	"txt":  "text",
+	"usr":  "user",
+}
+
+// NoAbbreviations checks that type and field names spell out words instead of abbreviating them
+type NoAbbreviations struct {
+	abbreviations map[string]string
+}
+
+// NewNoAbbreviations creates a new instance of the NoAbbreviations rule.
+// Keys of abbreviations are lowercase abbreviations and values their expansions; if it is empty, DefaultAbbreviations is used.
+func NewNoAbbreviations(abbreviations map[string]string) *NoAbbreviations {
+	if len(abbreviations) == 0 {
+		abbreviations = DefaultAbbreviations
+	}
+	return &NoAbbreviations{abbreviations: abbreviations}
+}
+
+// Name returns the rule name
+func (r *NoAbbreviations) Name() string {
+	return "no-abbreviations"
+}
+
+// Description returns what this rule checks
+func (r *NoAbbreviations) Description() string {
+	return "Type and field names should not use abbreviations like `usr`, `addr` or `cfg`"
+}
+
+// Check validates that type and field names contain no abbreviated words
+func (r *NoAbbreviations) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+
+		for _, abbreviation := range r.findAbbreviations(def.Name) {
+			errors = append(errors, r.buildError(source, def.Position,
+				fmt.Sprintf("Type `%s` uses abbreviation `%s`; consider `%s`.", def.Name, abbreviation, r.abbreviations[abbreviation])))
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			for _, abbreviation := range r.findAbbreviations(field.Name) {
+				errors = append(errors, r.buildError(source, field.Position,
+					fmt.Sprintf("Field `%s.%s` uses abbreviation `%s`; consider `%s`.", def.Name, field.Name, abbreviation, r.abbreviations[abbreviation])))
+			}
+		}
+	}
+
+	return errors
+}
+
+// findAbbreviations returns the abbreviations used as whole words in a camelCase, PascalCase or snake_case name
+func (r *NoAbbreviations) findAbbreviations(name string) []string {
+	var found []string
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(name, "_") {
+		for _, word := range splitCamelCase(part) {
+			word = strings.ToLower(word)
+			if _, ok := r.abbreviations[word]; ok && !seen[word] {
+				seen[word] = true
+				found = append(found, word)
+			}
+		}
+	}
+	return found
+}
+
+// buildError creates a lint error at the given position
+func (r *NoAbbreviations) buildError(source *ast.Source, position *ast.Position, message string) types.LintError {
+	line, column := 1, 1
+	if position != nil {
+		line = position.Line
+		column = position.Column
+	}
+
+	return types.LintError{
+		Message: message,
+		Location: types.Location{
+			Line:   line,
+			Column: column,
+			File:   source.Name,
+		},
+		Rule: r.Name(),
+	}
+}
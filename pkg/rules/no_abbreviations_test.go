@@ -0,0 +1,71 @@
+package rules
+
+import "testing"
+
+func TestNoAbbreviations(t *testing.T) {
+	rule := NewNoAbbreviations(nil)
+
+	t.Run("should flag abbreviated words in type and field names", func(t *testing.T) {
+		schema := `
+		type UsrProfile {
+			addr: String
+			billingAddr: String
+			num_items: Int
+		}
+
+		input UpdateCfgInput {
+			msgText: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-abbreviations") != 6 {
+			t.Errorf("Expected 6 errors for abbreviations, got %d", countRuleErrors(errors, "no-abbreviations"))
+		}
+
+		expectedMessages := []string{
+			"Type `UsrProfile` uses abbreviation `usr`; consider `user`.",
+			"Field `UsrProfile.addr` uses abbreviation `addr`; consider `address`.",
+			"Field `UsrProfile.billingAddr` uses abbreviation `addr`; consider `address`.",
+			"Field `UsrProfile.num_items` uses abbreviation `num`; consider `number`.",
+			"Type `UpdateCfgInput` uses abbreviation `cfg`; consider `config`.",
+			"Field `UpdateCfgInput.msgText` uses abbreviation `msg`; consider `message`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should respect word boundaries", func(t *testing.T) {
+		schema := `
+		type User {
+			address: String
+			number: Int
+			message: String
+			numeric: Boolean
+			username: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-abbreviations") > 0 {
+			t.Errorf("Expected no errors for spelled out words, got %d", countRuleErrors(errors, "no-abbreviations"))
+		}
+	})
+
+	t.Run("should use a configured dictionary", func(t *testing.T) {
+		schema := `
+		type Order {
+			addr: String
+			custName: String
+		}
+		`
+		errors := runRule(t, NewNoAbbreviations(map[string]string{"cust": "customer"}), schema)
+		if countRuleErrors(errors, "no-abbreviations") != 1 {
+			t.Errorf("Expected 1 error with a custom dictionary, got %d", countRuleErrors(errors, "no-abbreviations"))
+		}
+		if !containsError(errors, "Field `Order.custName` uses abbreviation `cust`; consider `customer`.") {
+			t.Error("Expected custName to be flagged")
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NoCaseCollidingArguments checks that argument names within a field, and field names within a type, don't differ only by case
+type NoCaseCollidingArguments struct{}
+
+// NewNoCaseCollidingArguments creates a new instance of the NoCaseCollidingArguments rule
+func NewNoCaseCollidingArguments() *NoCaseCollidingArguments {
+	return &NoCaseCollidingArguments{}
+}
+
+// Name returns the rule name
+func (r *NoCaseCollidingArguments) Name() string {
+	return "no-case-colliding-arguments"
+}
+
+// Description returns what this rule checks
+func (r *NoCaseCollidingArguments) Description() string {
+	return "Argument names within a field, and field names within a type, should not differ only by case"
+}
+
+// Check validates that no two sibling names differ only by case
+func (r *NoCaseCollidingArguments) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+
+		fieldNames := make([]string, len(def.Fields))
+		for i, field := range def.Fields {
+			fieldNames[i] = field.Name
+		}
+		for _, pair := range r.findCollisions(fieldNames) {
+			field := def.Fields[pair[1]]
+			errors = append(errors, r.newError(source, fmt.Sprintf("Fields `%s` and `%s` on `%s` differ only by case.",
+				def.Fields[pair[0]].Name, field.Name, def.Name), field.Position))
+		}
+
+		for _, field := range def.Fields {
+			argNames := make([]string, len(field.Arguments))
+			for i, arg := range field.Arguments {
+				argNames[i] = arg.Name
+			}
+			for _, pair := range r.findCollisions(argNames) {
+				arg := field.Arguments[pair[1]]
+				errors = append(errors, r.newError(source, fmt.Sprintf("Arguments `%s` and `%s` on `%s.%s` differ only by case.",
+					field.Arguments[pair[0]].Name, arg.Name, def.Name, field.Name), arg.Position))
+			}
+		}
+	}
+
+	for _, directive := range schema.Directives {
+		// Skip built-in directives
+		if directive.Position == nil || directive.Position.Src == nil || directive.Position.Src.BuiltIn {
+			continue
+		}
+
+		argNames := make([]string, len(directive.Arguments))
+		for i, arg := range directive.Arguments {
+			argNames[i] = arg.Name
+		}
+		for _, pair := range r.findCollisions(argNames) {
+			arg := directive.Arguments[pair[1]]
+			errors = append(errors, r.newError(source, fmt.Sprintf("Arguments `%s` and `%s` on `@%s` differ only by case.",
+				directive.Arguments[pair[0]].Name, arg.Name, directive.Name), arg.Position))
+		}
+	}
+
+	return errors
+}
+
+// findCollisions returns index pairs of names that are equal when lowercased, pairing each later name with the first one
+func (r *NoCaseCollidingArguments) findCollisions(names []string) [][2]int {
+	var collisions [][2]int
+	first := make(map[string]int)
+	for i, name := range names {
+		key := strings.ToLower(name)
+		if j, ok := first[key]; ok {
+			collisions = append(collisions, [2]int{j, i})
+			continue
+		}
+		first[key] = i
+	}
+	return collisions
+}
+
+// newError creates an error reported at the colliding name
+func (r *NoCaseCollidingArguments) newError(source *ast.Source, message string, position *ast.Position) types.LintError {
+	line, column := 1, 1
+	if position != nil {
+		line = position.Line
+		column = position.Column
+	}
+
+	return types.LintError{
+		Message: message,
+		Location: types.Location{
+			Line:   line,
+			Column: column,
+			File:   source.Name,
+		},
+		Rule: r.Name(),
+	}
+}
@@ -0,0 +1,61 @@
+package rules
+
+import "testing"
+
+func TestNoCaseCollidingArguments(t *testing.T) {
+	rule := NewNoCaseCollidingArguments()
+
+	t.Run("should allow distinct names", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			userId: ID
+		}
+
+		type Query {
+			user(id: ID, userId: ID): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-case-colliding-arguments") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag arguments and fields differing only by case", func(t *testing.T) {
+		schema := `
+		directive @cache(maxAge: Int, MaxAge: Int) on FIELD_DEFINITION
+
+		type User {
+			id: ID!
+			userId: ID
+			userID: ID
+		}
+
+		input UserFilter {
+			name: String
+			Name: String
+		}
+
+		type Query {
+			user(userId: ID, userID: ID): User
+			users(filter: UserFilter): [User]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Arguments `userId` and `userID` on `Query.user` differ only by case.",
+			"Fields `userId` and `userID` on `User` differ only by case.",
+			"Fields `name` and `Name` on `UserFilter` differ only by case.",
+			"Arguments `maxAge` and `MaxAge` on `@cache` differ only by case.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "no-case-colliding-arguments") != 4 {
+			t.Errorf("Expected 4 errors, got %v", errors)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+	"github.com/nishant-rn/gqlparser/v2/parser"
+)
+
+// NoConflictingTypeDefinitions checks that a type name is declared with a single kind across all schema files
+type NoConflictingTypeDefinitions struct{}
+
+// NewNoConflictingTypeDefinitions creates a new instance of the NoConflictingTypeDefinitions rule
+func NewNoConflictingTypeDefinitions() *NoConflictingTypeDefinitions {
+	return &NoConflictingTypeDefinitions{}
+}
+
+// Name returns the rule name
+func (r *NoConflictingTypeDefinitions) Name() string {
+	return "no-conflicting-type-definitions"
+}
+
+// Description returns what this rule checks
+func (r *NoConflictingTypeDefinitions) Description() string {
+	return "A type name must be declared and extended with the same kind in every schema file"
+}
+
+// Check validates a single file. The schema parser already rejects conflicting
+// kinds within one file, so conflicts are only found by CheckFiles.
+func (r *NoConflictingTypeDefinitions) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	return nil
+}
+
+// CheckFiles validates that definitions and extensions of a type agree on its kind across files
+func (r *NoConflictingTypeDefinitions) CheckFiles(sources []*ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	// Collect every definition and extension in file order
+	var names []string
+	byName := make(map[string][]*ast.Definition)
+	for _, source := range sources {
+		doc, err := parser.ParseSchema(source)
+		if err != nil {
+			continue
+		}
+		for _, def := range append(doc.Definitions, doc.Extensions...) {
+			if _, seen := byName[def.Name]; !seen {
+				names = append(names, def.Name)
+			}
+			byName[def.Name] = append(byName[def.Name], def)
+		}
+	}
+
+	for _, name := range names {
+		defs := byName[name]
+
+		var kinds []string
+		seenKinds := make(map[ast.DefinitionKind]bool)
+		for _, def := range defs {
+			if !seenKinds[def.Kind] {
+				seenKinds[def.Kind] = true
+				kinds = append(kinds, kindDisplayName(def.Kind))
+			}
+		}
+		if len(kinds) < 2 {
+			continue
+		}
+
+		message := fmt.Sprintf("Type `%s` is declared with conflicting kinds (%s).", name, joinKinds(kinds))
+
+		// Report every declaration that disagrees with the first one
+		for _, def := range defs {
+			if def.Kind == defs[0].Kind {
+				continue
+			}
+
+			line, column, file := 1, 1, ""
+			if def.Position != nil {
+				line = def.Position.Line
+				column = def.Position.Column
+				if def.Position.Src != nil {
+					file = def.Position.Src.Name
+				}
+			}
+
+			errors = append(errors, types.LintError{
+				Message: message,
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   file,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// kindDisplayName returns a readable name for a definition kind, e.g. "Input Object"
+func kindDisplayName(kind ast.DefinitionKind) string {
+	words := strings.Split(strings.ToLower(string(kind)), "_")
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// joinKinds joins kind names as "A and B" or "A, B and C"
+func joinKinds(kinds []string) string {
+	if len(kinds) < 2 {
+		return strings.Join(kinds, "")
+	}
+	return strings.Join(kinds[:len(kinds)-1], ", ") + " and " + kinds[len(kinds)-1]
+}
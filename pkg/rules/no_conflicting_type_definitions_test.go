@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+func TestNoConflictingTypeDefinitions(t *testing.T) {
+	rule := NewNoConflictingTypeDefinitions()
+
+	t.Run("should allow the same kind across files", func(t *testing.T) {
+		sources := []*ast.Source{
+			{Name: "users.graphql", Input: `type User { id: ID! }`},
+			{Name: "reviews.graphql", Input: `extend type User { reviews: [String!] }`},
+		}
+		errors := rule.CheckFiles(sources)
+		if len(errors) > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag a type declared with different kinds", func(t *testing.T) {
+		sources := []*ast.Source{
+			{Name: "users.graphql", Input: `type User { id: ID! }`},
+			{Name: "accounts.graphql", Input: `interface User { id: ID! }`},
+		}
+		errors := rule.CheckFiles(sources)
+		if len(errors) != 1 {
+			t.Fatalf("Expected 1 error, got %d", len(errors))
+		}
+
+		expectedMessage := "Type `User` is declared with conflicting kinds (Object and Interface)."
+		if errors[0].Message != expectedMessage {
+			t.Errorf("Expected error message %q, got %q", expectedMessage, errors[0].Message)
+		}
+		if errors[0].Location.File != "accounts.graphql" {
+			t.Errorf("Expected error in accounts.graphql, got %s", errors[0].Location.File)
+		}
+	})
+
+	t.Run("should flag an extension with a different kind", func(t *testing.T) {
+		sources := []*ast.Source{
+			{Name: "nodes.graphql", Input: `interface Node { id: ID! }`},
+			{Name: "users.graphql", Input: `extend type Node { name: String }`},
+			{Name: "inputs.graphql", Input: `input Node { id: ID! }`},
+		}
+		errors := rule.CheckFiles(sources)
+		if len(errors) != 2 {
+			t.Fatalf("Expected 2 errors, got %d", len(errors))
+		}
+
+		expectedMessage := "Type `Node` is declared with conflicting kinds (Interface, Object and Input Object)."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should not report anything for a single file", func(t *testing.T) {
+		errors := runRule(t, rule, `type User { id: ID! }`)
+		if len(errors) > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+}
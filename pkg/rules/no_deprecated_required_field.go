@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NoDeprecatedRequiredField checks that deprecated fields are nullable
+type NoDeprecatedRequiredField struct {
+	deprecationLint *RequireDeprecationReason
+}
+
+// NewNoDeprecatedRequiredField creates a new instance of the NoDeprecatedRequiredField rule
+func NewNoDeprecatedRequiredField() *NoDeprecatedRequiredField {
+	return &NoDeprecatedRequiredField{deprecationLint: NewRequireDeprecationReason()}
+}
+
+// Name returns the rule name
+func (r *NoDeprecatedRequiredField) Name() string {
+	return "no-deprecated-required-field"
+}
+
+// Description returns what this rule checks
+func (r *NoDeprecatedRequiredField) Description() string {
+	return "Deprecated fields should be nullable so clients can migrate away from them gradually (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *NoDeprecatedRequiredField) OptIn() bool {
+	return true
+}
+
+// Check validates that no deprecated field is non-null
+func (r *NoDeprecatedRequiredField) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface && def.Kind != ast.InputObject {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			if !field.Type.NonNull || r.deprecationLint.findDeprecatedDirective(field.Directives) == nil {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Deprecated field `%s.%s` is non-null; deprecated fields should be nullable to ease client migration.", def.Name, field.Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
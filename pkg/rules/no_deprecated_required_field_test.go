@@ -0,0 +1,52 @@
+package rules
+
+import "testing"
+
+func TestNoDeprecatedRequiredField(t *testing.T) {
+	rule := NewNoDeprecatedRequiredField()
+
+	t.Run("should allow nullable deprecated fields", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			legacyId: String @deprecated(reason: "Use id instead.")
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-deprecated-required-field") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag non-null deprecated fields", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			legacyId: String! @deprecated(reason: "Use id instead.")
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-deprecated-required-field") != 1 {
+			t.Errorf("Expected 1 error for non-null deprecated field, got %d", countRuleErrors(errors, "no-deprecated-required-field"))
+		}
+
+		expectedMessage := "Deprecated field `User.legacyId` is non-null; deprecated fields should be nullable to ease client migration."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
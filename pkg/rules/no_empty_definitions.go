@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NoEmptyDefinitions checks that types, interfaces, inputs, enums and unions are not empty
+type NoEmptyDefinitions struct{}
+
+// NewNoEmptyDefinitions creates a new instance of the NoEmptyDefinitions rule
+func NewNoEmptyDefinitions() *NoEmptyDefinitions {
+	return &NoEmptyDefinitions{}
+}
+
+// Name returns the rule name
+func (r *NoEmptyDefinitions) Name() string {
+	return "no-empty-definitions"
+}
+
+// Description returns what this rule checks
+func (r *NoEmptyDefinitions) Description() string {
+	return "Object, interface and input types must have fields, enums must have values and unions must have members. Types marked @extends only exist to be extended and are skipped"
+}
+
+// Check validates that no definition is empty
+func (r *NoEmptyDefinitions) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+
+		// Federation stubs are filled in by another subgraph
+		if def.Directives.ForName("extends") != nil {
+			continue
+		}
+
+		var message string
+		switch def.Kind {
+		case ast.Object, ast.Interface, ast.InputObject:
+			if r.hasFields(def) {
+				continue
+			}
+			message = fmt.Sprintf("Type `%s` has no fields; remove it or add fields.", def.Name)
+		case ast.Enum:
+			if len(def.EnumValues) > 0 {
+				continue
+			}
+			message = fmt.Sprintf("Enum `%s` has no values; remove it or add values.", def.Name)
+		case ast.Union:
+			if len(def.Types) > 0 {
+				continue
+			}
+			message = fmt.Sprintf("Union `%s` has no members; remove it or add members.", def.Name)
+		default:
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: message,
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// hasFields checks if a type has fields other than the introspection fields added to the Query type
+func (r *NoEmptyDefinitions) hasFields(def *ast.Definition) bool {
+	for _, field := range def.Fields {
+		if !strings.HasPrefix(field.Name, "__") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package rules
+
+import "testing"
+
+func TestNoEmptyDefinitions(t *testing.T) {
+	rule := NewNoEmptyDefinitions()
+
+	const schemaStr = `
+	directive @extends on OBJECT
+
+	type Placeholder {
+		id: ID!
+	}
+
+	interface Node {
+		id: ID!
+	}
+
+	input PlaceholderInput {
+		id: ID!
+	}
+
+	enum Color {
+		RED
+	}
+
+	union Result = Placeholder
+
+	type Product @extends {
+		id: ID!
+	}
+
+	type Query {
+		placeholder(input: PlaceholderInput): Placeholder
+		color: Color
+		result: Result
+		product: Product
+	}
+	`
+
+	t.Run("should allow definitions with members", func(t *testing.T) {
+		errors := runRule(t, rule, schemaStr)
+		if countRuleErrors(errors, "no-empty-definitions") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag unions without members", func(t *testing.T) {
+		schema := `
+		union Empty
+
+		type Query {
+			empty: Empty
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Union `Empty` has no members; remove it or add members."
+		if countRuleErrors(errors, "no-empty-definitions") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should flag types, interfaces, inputs and enums without members", func(t *testing.T) {
+		// The parser rejects most empty definitions, so empty them after loading
+		schema, source := parseSchema(t, schemaStr)
+		for _, name := range []string{"Placeholder", "Node", "PlaceholderInput", "Product"} {
+			schema.Types[name].Fields = nil
+		}
+		schema.Types["Color"].EnumValues = nil
+
+		errors := rule.Check(schema, source)
+		if countRuleErrors(errors, "no-empty-definitions") != 4 {
+			t.Errorf("Expected 4 errors, got %d", countRuleErrors(errors, "no-empty-definitions"))
+		}
+
+		expectedMessages := []string{
+			"Type `Placeholder` has no fields; remove it or add fields.",
+			"Type `Node` has no fields; remove it or add fields.",
+			"Type `PlaceholderInput` has no fields; remove it or add fields.",
+			"Enum `Color` has no values; remove it or add values.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+}
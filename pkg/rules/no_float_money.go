@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultMoneyScalar is the scalar suggested for monetary fields when none is configured
+const DefaultMoneyScalar = "Decimal"
+
+// NoFloatMoney checks that monetary fields are not typed as Float
+type NoFloatMoney struct {
+	concept ScalarConcept
+}
+
+// NewNoFloatMoney creates a new instance of the NoFloatMoney rule.
+// Fields with a camelCase word in tokens are monetary, e.g. `price` and `priceTier` for "price"; if tokens is
+// empty, MoneyConcept is used. If suggested is empty, DefaultMoneyScalar is used.
+func NewNoFloatMoney(tokens []string, suggested string) *NoFloatMoney {
+	if suggested == "" {
+		suggested = DefaultMoneyScalar
+	}
+	concept := MoneyConcept
+	if len(tokens) > 0 {
+		concept.Words = tokens
+	}
+	concept.Scalar = suggested
+	return &NoFloatMoney{concept: concept}
+}
+
+// Name returns the rule name
+func (r *NoFloatMoney) Name() string {
+	return "no-float-money"
+}
+
+// Description returns what this rule checks
+func (r *NoFloatMoney) Description() string {
+	return "Monetary fields (price, amount, cost, ...) must not use Float, which leads to rounding bugs; use a precise scalar or integer cents"
+}
+
+// Check validates that no monetary field is typed as Float
+func (r *NoFloatMoney) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	visitConceptFields(schema, []ScalarConcept{r.concept}, func(def *ast.Definition, field *ast.FieldDefinition, concept ScalarConcept) {
+		if field.Type.NamedType != "Float" {
+			return
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Field `%s.%s` is a monetary value typed `Float`; use a precise scalar like `%s` or integer cents.", def.Name, field.Name, concept.Scalar),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	})
+
+	return errors
+}
@@ -0,0 +1,83 @@
+package rules
+
+import "testing"
+
+func TestNoFloatMoney(t *testing.T) {
+	rule := NewNoFloatMoney(nil, "")
+
+	t.Run("should flag monetary fields typed Float", func(t *testing.T) {
+		schema := `
+		type Order {
+			totalAmount: Float
+			shippingFee: Float!
+			weight: Float
+		}
+
+		input OrderInput {
+			price: Float!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-float-money") != 3 {
+			t.Errorf("Expected 3 errors for Float monetary fields, got %d", countRuleErrors(errors, "no-float-money"))
+		}
+
+		expectedMessage := "Field `Order.totalAmount` is a monetary value typed `Float`; use a precise scalar like `Decimal` or integer cents."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should pass monetary fields using precise types", func(t *testing.T) {
+		schema := `
+		scalar Decimal
+
+		type Order {
+			totalAmount: Decimal
+			priceCents: Int!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-float-money") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "no-float-money"))
+		}
+	})
+
+	t.Run("should use configured tokens and suggestion", func(t *testing.T) {
+		schema := `
+		type Invoice {
+			tax: Float
+			price: Float
+		}
+		`
+		errors := runRule(t, NewNoFloatMoney([]string{"tax"}, "Money"), schema)
+		if countRuleErrors(errors, "no-float-money") != 1 {
+			t.Errorf("Expected 1 error with custom tokens, got %d", countRuleErrors(errors, "no-float-money"))
+		}
+		if !containsError(errors, "Field `Invoice.tax` is a monetary value typed `Float`; use a precise scalar like `Money` or integer cents.") {
+			t.Error("Expected Invoice.tax to be flagged with the configured suggestion")
+		}
+	})
+
+	t.Run("should flag money tokens anywhere in the name", func(t *testing.T) {
+		schema := `
+		type Order {
+			total: Float
+			amountDue: Float
+			price: Float
+			totalAmount: Float
+			feedback: Float
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-float-money") != 4 {
+			t.Errorf("Expected 4 errors, got %v", errors)
+		}
+		for _, name := range []string{"total", "amountDue"} {
+			expectedMessage := "Field `Order." + name + "` is a monetary value typed `Float`; use a precise scalar like `Decimal` or integer cents."
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/anirudhraja/gqllinter/pkg/types"
 	"github.com/nishant-rn/gqlparser/v2/ast"
+	"github.com/nishant-rn/gqlparser/v2/parser"
 )
 
 // NoHashtagDescription checks that descriptions use triple quotes instead of hashtag comments
@@ -32,27 +33,18 @@ func (r *NoHashtagDescription) Check(schema *ast.Schema, source *ast.Source) []t
 	// Parse the source to find hashtag comments that should be descriptions
 	lines := strings.Split(source.Input, "\n")
 
-	for i, line := range lines {
-		trimmed := strings.TrimSpace(line)
-
-		// Look for hashtag comments that appear to be descriptions
-		if strings.HasPrefix(trimmed, "#") && !strings.HasPrefix(trimmed, "# gqllinter") {
-			// Check if this appears before a type or field definition
-			if i+1 < len(lines) {
-				nextLine := strings.TrimSpace(lines[i+1])
-				if r.looksLikeDefinition(nextLine) {
-					errors = append(errors, types.LintError{
-						Message: "Use triple quotes (\"\"\") for descriptions instead of hashtag comments.",
-						Location: types.Location{
-							Line:   i + 1, // 1-indexed
-							Column: strings.Index(line, "#") + 1,
-							File:   source.Name,
-						},
-						Rule: r.Name(),
-					})
-				}
-			}
-		}
+	for _, block := range r.findCommentBlocks(lines) {
+		// Report the comment line directly above the definition
+		line := lines[block.end-1]
+		errors = append(errors, types.LintError{
+			Message: "Use triple quotes (\"\"\") for descriptions instead of hashtag comments.",
+			Location: types.Location{
+				Line:   block.end, // 1-indexed
+				Column: strings.Index(line, "#") + 1,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
 	}
 
 	return errors
@@ -77,3 +69,119 @@ func (r *NoHashtagDescription) looksLikeDefinition(line string) bool {
 		strings.HasPrefix(line, "union ") ||
 		strings.Contains(line, ":") // field definition
 }
+
+// isDescriptionComment checks if a trimmed line is a hashtag comment that isn't a gqllinter pragma
+func (r *NoHashtagDescription) isDescriptionComment(trimmed string) bool {
+	return strings.HasPrefix(trimmed, "#") && !strings.HasPrefix(trimmed, "# gqllinter")
+}
+
+// commentBlock is a run of consecutive comment lines [start, end) directly above a definition
+type commentBlock struct {
+	start int
+	end   int
+}
+
+// Fix converts hashtag comments directly above definitions into block string descriptions.
+// Consecutive comment lines are merged into a single description; other comments are left untouched.
+func (r *NoHashtagDescription) Fix(source *ast.Source) (string, error) {
+	lines := strings.Split(source.Input, "\n")
+	blocks := r.findCommentBlocks(lines)
+	if len(blocks) == 0 {
+		return source.Input, nil
+	}
+
+	if fixed := r.convertBlocks(lines, blocks); r.parses(fixed) {
+		return fixed, nil
+	}
+
+	// Some comments sit where a description isn't allowed (e.g. in the schema definition or
+	// after an existing description), so keep only the conversions that leave the document valid
+	var kept []commentBlock
+	for _, block := range blocks {
+		candidate := append(append([]commentBlock{}, kept...), block)
+		if r.parses(r.convertBlocks(lines, candidate)) {
+			kept = candidate
+		}
+	}
+
+	return r.convertBlocks(lines, kept), nil
+}
+
+// findCommentBlocks finds the comment runs that Check would flag, including the comment lines above them
+func (r *NoHashtagDescription) findCommentBlocks(lines []string) []commentBlock {
+	var blocks []commentBlock
+	for i := 0; i+1 < len(lines); i++ {
+		if !r.isDescriptionComment(strings.TrimSpace(lines[i])) || !r.looksLikeDefinition(strings.TrimSpace(lines[i+1])) {
+			continue
+		}
+
+		start := i
+		for start > 0 && r.isDescriptionComment(strings.TrimSpace(lines[start-1])) {
+			start--
+		}
+
+		// Bare `#` lines have nothing to turn into a description
+		if len(r.commentTexts(lines[start:i+1])) == 0 {
+			continue
+		}
+		blocks = append(blocks, commentBlock{start: start, end: i + 1})
+	}
+	return blocks
+}
+
+// commentTexts returns the text of comment lines with blank lines at either end dropped
+func (r *NoHashtagDescription) commentTexts(lines []string) []string {
+	var texts []string
+	for _, line := range lines {
+		text := strings.TrimPrefix(strings.TrimSpace(line), "#")
+		texts = append(texts, strings.TrimRight(strings.TrimPrefix(text, " "), " \t"))
+	}
+	for len(texts) > 0 && strings.TrimSpace(texts[0]) == "" {
+		texts = texts[1:]
+	}
+	for len(texts) > 0 && strings.TrimSpace(texts[len(texts)-1]) == "" {
+		texts = texts[:len(texts)-1]
+	}
+	return texts
+}
+
+// convertBlocks replaces each comment block with a description at the comment's indentation
+func (r *NoHashtagDescription) convertBlocks(lines []string, blocks []commentBlock) string {
+	var result []string
+	next := 0
+	for _, block := range blocks {
+		result = append(result, lines[next:block.start]...)
+
+		indent := lines[block.start][:strings.Index(lines[block.start], "#")]
+		var texts []string
+		for _, text := range r.commentTexts(lines[block.start:block.end]) {
+			texts = append(texts, strings.ReplaceAll(text, `"""`, `\"""`))
+		}
+
+		// A single line fits on one line unless its closing quote would merge with the delimiter
+		if len(texts) == 1 && !strings.HasSuffix(texts[0], `"`) {
+			result = append(result, indent+`"""`+texts[0]+`"""`)
+		} else {
+			result = append(result, indent+`"""`)
+			for _, text := range texts {
+				if text == "" {
+					result = append(result, "")
+					continue
+				}
+				result = append(result, indent+text)
+			}
+			result = append(result, indent+`"""`)
+		}
+
+		next = block.end
+	}
+	result = append(result, lines[next:]...)
+
+	return strings.Join(result, "\n")
+}
+
+// parses checks if the input is still a syntactically valid schema document
+func (r *NoHashtagDescription) parses(input string) bool {
+	_, err := parser.ParseSchema(&ast.Source{Input: input})
+	return err == nil
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NoListOfLists checks that fields and arguments don't use nested list types
+type NoListOfLists struct{}
+
+// NewNoListOfLists creates a new instance of the NoListOfLists rule
+func NewNoListOfLists() *NoListOfLists {
+	return &NoListOfLists{}
+}
+
+// Name returns the rule name
+func (r *NoListOfLists) Name() string {
+	return "no-list-of-lists"
+}
+
+// Description returns what this rule checks
+func (r *NoListOfLists) Description() string {
+	return "Fields and arguments should not use nested lists like [[Cell]]; model rows with a dedicated type instead. Connection edges are checked by relay-connection-types"
+}
+
+// Check validates that no field or argument type is a list of lists
+func (r *NoListOfLists) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface && def.Kind != ast.InputObject {
+			continue
+		}
+
+		isConnection := strings.HasSuffix(strings.ToLower(def.Name), "connection")
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			// Nested connection edges are already reported by relay-connection-types
+			if isNestedListType(field.Type) && !(isConnection && field.Name == "edges") {
+				message := fmt.Sprintf("Field `%s.%s` returns a nested list `%s`; consider a dedicated row type.", def.Name, field.Name, field.Type.String())
+				if def.Kind == ast.InputObject {
+					message = fmt.Sprintf("Field `%s.%s` is a nested list `%s`; consider a dedicated input type.", def.Name, field.Name, field.Type.String())
+				}
+				errors = append(errors, r.newError(source, message, field.Position))
+			}
+
+			for _, arg := range field.Arguments {
+				if isNestedListType(arg.Type) {
+					message := fmt.Sprintf("Argument `%s` on `%s.%s` is a nested list `%s`; consider a dedicated input type.", arg.Name, def.Name, field.Name, arg.Type.String())
+					errors = append(errors, r.newError(source, message, arg.Position))
+				}
+			}
+		}
+	}
+
+	return errors
+}
+
+// newError creates an error at the given position
+func (r *NoListOfLists) newError(source *ast.Source, message string, position *ast.Position) types.LintError {
+	line, column := 1, 1
+	if position != nil {
+		line = position.Line
+		column = position.Column
+	}
+
+	return types.LintError{
+		Message: message,
+		Location: types.Location{
+			Line:   line,
+			Column: column,
+			File:   source.Name,
+		},
+		Rule: r.Name(),
+	}
+}
@@ -0,0 +1,79 @@
+package rules
+
+import "testing"
+
+func TestNoListOfLists(t *testing.T) {
+	rule := NewNoListOfLists()
+
+	t.Run("should allow flat lists and connection edges", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type Cell {
+			value: Int
+		}
+
+		type Row {
+			cells: [Cell!]!
+		}
+
+		type CellEdge {
+			cursor: String!
+			node: Cell
+		}
+
+		type CellConnection {
+			edges: [[CellEdge]]
+			pageInfo: PageInfo!
+		}
+
+		type Board {
+			rows: [Row!]!
+			cells(first: Int, after: String): CellConnection
+		}
+
+		type Query {
+			board(ids: [ID!]): Board
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-list-of-lists") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag nested lists at any depth", func(t *testing.T) {
+		schema := `
+		type Cell {
+			value: Int
+		}
+
+		type Board {
+			grid: [[Cell]]
+			cube: [[[Cell!]!]!]!
+		}
+
+		input BoardInput {
+			grid: [[Int]]
+		}
+
+		type Query {
+			board(input: BoardInput, path: [[Int!]!]): Board
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-list-of-lists") != 4 {
+			t.Errorf("Expected 4 errors for nested lists, got %d", countRuleErrors(errors, "no-list-of-lists"))
+		}
+
+		expectedMessages := []string{
+			"Field `Board.grid` returns a nested list `[[Cell]]`; consider a dedicated row type.",
+			"Field `Board.cube` returns a nested list `[[[Cell!]!]!]!`; consider a dedicated row type.",
+			"Field `BoardInput.grid` is a nested list `[[Int]]`; consider a dedicated input type.",
+			"Argument `path` on `Query.board` is a nested list `[[Int!]!]`; consider a dedicated input type.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+}
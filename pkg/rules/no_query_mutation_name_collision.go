@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NoQueryMutationNameCollision checks that Query and Mutation fields don't share names
+type NoQueryMutationNameCollision struct {
+	allowed map[string]bool
+}
+
+// NewNoQueryMutationNameCollision creates a new instance of the NoQueryMutationNameCollision rule.
+// Field names in allowed may intentionally exist on both root types.
+func NewNoQueryMutationNameCollision(allowed []string) *NoQueryMutationNameCollision {
+	allowedMap := make(map[string]bool)
+	for _, name := range allowed {
+		allowedMap[name] = true
+	}
+	return &NoQueryMutationNameCollision{allowed: allowedMap}
+}
+
+// Name returns the rule name
+func (r *NoQueryMutationNameCollision) Name() string {
+	return "no-query-mutation-name-collision"
+}
+
+// Description returns what this rule checks
+func (r *NoQueryMutationNameCollision) Description() string {
+	return "Query and Mutation fields should not share names, since identically named operations are confusing"
+}
+
+// Check validates that no field name exists on both the Query and Mutation types
+func (r *NoQueryMutationNameCollision) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	if schema.Query == nil || schema.Mutation == nil {
+		return errors
+	}
+
+	queryFields := make(map[string]bool)
+	for _, field := range schema.Query.Fields {
+		queryFields[field.Name] = true
+	}
+
+	for _, field := range schema.Mutation.Fields {
+		// Skip introspection fields and intentional overlaps
+		if strings.HasPrefix(field.Name, "__") || r.allowed[field.Name] {
+			continue
+		}
+
+		if !queryFields[field.Name] {
+			continue
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Field name `%s` exists on both Query and Mutation; disambiguate them.", field.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
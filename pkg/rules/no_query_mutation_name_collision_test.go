@@ -0,0 +1,62 @@
+package rules
+
+import "testing"
+
+func TestNoQueryMutationNameCollision(t *testing.T) {
+	rule := NewNoQueryMutationNameCollision(nil)
+
+	t.Run("should flag field names on both Query and Mutation", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type Query {
+			user(id: ID!): User
+			users: [User!]
+		}
+
+		type Mutation {
+			user(id: ID!): User
+			createUser(name: String!): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-query-mutation-name-collision") != 1 {
+			t.Errorf("Expected 1 error for colliding field names, got %d", countRuleErrors(errors, "no-query-mutation-name-collision"))
+		}
+
+		expectedMessage := "Field name `user` exists on both Query and Mutation; disambiguate them."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should allow configured overlaps", func(t *testing.T) {
+		schema := `
+		type Query {
+			ping: String
+		}
+
+		type Mutation {
+			ping: String
+		}
+		`
+		errors := runRule(t, NewNoQueryMutationNameCollision([]string{"ping"}), schema)
+		if countRuleErrors(errors, "no-query-mutation-name-collision") > 0 {
+			t.Error("Expected no errors for allowlisted field names")
+		}
+	})
+
+	t.Run("should pass when there is no Mutation type", func(t *testing.T) {
+		schema := `
+		type Query {
+			ping: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-query-mutation-name-collision") > 0 {
+			t.Error("Expected no errors without a Mutation type")
+		}
+	})
+}
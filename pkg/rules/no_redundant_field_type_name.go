@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NoRedundantFieldTypeName checks for codegen-style redundancy between field names and type names
+type NoRedundantFieldTypeName struct{}
+
+// NewNoRedundantFieldTypeName creates a new instance of the NoRedundantFieldTypeName rule
+func NewNoRedundantFieldTypeName() *NoRedundantFieldTypeName {
+	return &NoRedundantFieldTypeName{}
+}
+
+// Name returns the rule name
+func (r *NoRedundantFieldTypeName) Name() string {
+	return "no-redundant-field-type-name"
+}
+
+// Description returns what this rule checks
+func (r *NoRedundantFieldTypeName) Description() string {
+	return "Field names should not redundantly repeat their parent type name or double a word (`User.userName`, `statusStatus`, `user: UserUser`)"
+}
+
+// Check validates that field names don't redundantly repeat type names
+func (r *NoRedundantFieldTypeName) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface && def.Kind != ast.InputObject {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			message := r.findRedundancy(def, field)
+			if message == "" {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: message,
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// findRedundancy returns a message describing the field's redundancy, or "" if it has none
+func (r *NoRedundantFieldTypeName) findRedundancy(def *ast.Definition, field *ast.FieldDefinition) string {
+	// `User.userName` - only when the prefix is exactly the camelCased parent type name
+	// and the shorter name isn't already taken by another field
+	prefix := strings.ToLower(def.Name[:1]) + def.Name[1:]
+	if remainder := strings.TrimPrefix(field.Name, prefix); remainder != field.Name && remainder != "" && isUpperASCII(remainder[0]) {
+		suggestion := strings.ToLower(remainder[:1]) + remainder[1:]
+		if def.Fields.ForName(suggestion) == nil {
+			return fmt.Sprintf("Field `%s.%s` redundantly repeats the type name; consider `%s`.", def.Name, field.Name, suggestion)
+		}
+	}
+
+	// `statusStatus` - the field name is the same word sequence written twice
+	if half, ok := doubledName(field.Name); ok {
+		return fmt.Sprintf("Field `%s.%s` redundantly repeats `%s`; consider `%s`.", def.Name, field.Name, half, half)
+	}
+
+	// `user: UserUser` - the return type doubles the field name
+	typeName := field.Type.Name()
+	if half, ok := doubledName(typeName); ok && strings.EqualFold(half, field.Name) {
+		return fmt.Sprintf("Field `%s.%s` returns `%s`, which redundantly repeats `%s`.", def.Name, field.Name, typeName, half)
+	}
+
+	return ""
+}
+
+// doubledName checks if a camelCase name consists of the same words repeated twice and returns the first half
+func doubledName(name string) (string, bool) {
+	words := splitCamelCase(name)
+	if len(words) == 0 || len(words)%2 != 0 {
+		return "", false
+	}
+
+	half := len(words) / 2
+	for i := 0; i < half; i++ {
+		if !strings.EqualFold(words[i], words[half+i]) {
+			return "", false
+		}
+	}
+	return strings.Join(words[:half], ""), true
+}
+
+// isUpperASCII checks if a byte is an ASCII uppercase letter
+func isUpperASCII(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
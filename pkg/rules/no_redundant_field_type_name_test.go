@@ -0,0 +1,61 @@
+package rules
+
+import "testing"
+
+func TestNoRedundantFieldTypeName(t *testing.T) {
+	rule := NewNoRedundantFieldTypeName()
+
+	t.Run("should flag fields repeating the parent type or doubling a word", func(t *testing.T) {
+		schema := `
+		type UserUser {
+			id: ID!
+		}
+
+		type User {
+			id: ID!
+			userName: String
+			statusStatus: String
+		}
+
+		type Post {
+			user: UserUser
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-redundant-field-type-name") != 3 {
+			t.Errorf("Expected 3 errors for redundant names, got %d", countRuleErrors(errors, "no-redundant-field-type-name"))
+		}
+
+		expectedMessages := []string{
+			"Field `User.userName` redundantly repeats the type name; consider `name`.",
+			"Field `User.statusStatus` redundantly repeats `status`; consider `status`.",
+			"Field `Post.user` returns `UserUser`, which redundantly repeats `User`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should stay conservative", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			userId: ID!
+			username: String
+			users: [String!]
+			bye: String
+		}
+
+		type Order {
+			orderedAt: String
+			order: Int
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-redundant-field-type-name") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "no-redundant-field-type-name"))
+		}
+	})
+}
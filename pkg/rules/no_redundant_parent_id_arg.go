@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NoRedundantParentIdArg checks for field arguments that repeat the identifier of the parent type
+type NoRedundantParentIdArg struct{}
+
+// NewNoRedundantParentIdArg creates a new instance of the NoRedundantParentIdArg rule
+func NewNoRedundantParentIdArg() *NoRedundantParentIdArg {
+	return &NoRedundantParentIdArg{}
+}
+
+// Name returns the rule name
+func (r *NoRedundantParentIdArg) Name() string {
+	return "no-redundant-parent-id-arg"
+}
+
+// Description returns what this rule checks
+func (r *NoRedundantParentIdArg) Description() string {
+	return "Fields on non-root types with an `id` should not take a `<parentType>Id` argument, since the parent is already identified (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *NoRedundantParentIdArg) OptIn() bool {
+	return true
+}
+
+// Check validates that field arguments do not repeat the parent's identifier
+func (r *NoRedundantParentIdArg) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+		if def == schema.Query || def == schema.Mutation || def == schema.Subscription {
+			continue
+		}
+		// Only types that carry their own identifier make the argument redundant
+		if def.Fields.ForName("id") == nil {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			for _, arg := range field.Arguments {
+				if !strings.EqualFold(arg.Name, def.Name+"Id") {
+					continue
+				}
+
+				line, column := 1, 1
+				if arg.Position != nil {
+					line = arg.Position.Line
+					column = arg.Position.Column
+				}
+
+				errors = append(errors, types.LintError{
+					Message: fmt.Sprintf("Argument `%s` on `%s.%s` is redundant; the parent `%s` is already identified.", arg.Name, def.Name, field.Name, def.Name),
+					Location: types.Location{
+						Line:   line,
+						Column: column,
+						File:   source.Name,
+					},
+					Rule: r.Name(),
+				})
+			}
+		}
+	}
+
+	return errors
+}
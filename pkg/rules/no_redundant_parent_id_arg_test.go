@@ -0,0 +1,81 @@
+package rules
+
+import "testing"
+
+func TestNoRedundantParentIdArg(t *testing.T) {
+	rule := NewNoRedundantParentIdArg()
+
+	t.Run("should allow arguments that identify other types", func(t *testing.T) {
+		schema := `
+		type Post {
+			id: ID!
+		}
+
+		type User {
+			id: ID!
+			posts(authorId: ID, first: Int): [Post!]!
+		}
+
+		type Query {
+			user(userId: ID!): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-redundant-parent-id-arg") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag arguments repeating the parent identifier", func(t *testing.T) {
+		schema := `
+		type Post {
+			id: ID!
+		}
+
+		type User {
+			id: ID!
+			posts(userId: ID!): [Post!]!
+			address(userID: ID): String
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Argument `userId` on `User.posts` is redundant; the parent `User` is already identified.",
+			"Argument `userID` on `User.address` is redundant; the parent `User` is already identified.",
+		}
+		if countRuleErrors(errors, "no-redundant-parent-id-arg") != len(expectedMessages) {
+			t.Errorf("Expected %d errors, got %v", len(expectedMessages), errors)
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+	})
+
+	t.Run("should skip types without an id field", func(t *testing.T) {
+		schema := `
+		type Settings {
+			theme(settingsId: ID): String
+		}
+
+		type Query {
+			settings: Settings
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-redundant-parent-id-arg") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NoRequiredRecursiveInput checks for input objects that reference themselves through required fields only
+type NoRequiredRecursiveInput struct{}
+
+// NewNoRequiredRecursiveInput creates a new instance of the NoRequiredRecursiveInput rule
+func NewNoRequiredRecursiveInput() *NoRequiredRecursiveInput {
+	return &NoRequiredRecursiveInput{}
+}
+
+// Name returns the rule name
+func (r *NoRequiredRecursiveInput) Name() string {
+	return "no-required-recursive-input"
+}
+
+// Description returns what this rule checks
+func (r *NoRequiredRecursiveInput) Description() string {
+	return "Input objects must not form a cycle of non-null fields, since such an input can never be constructed. Nullable or list recursion is allowed"
+}
+
+// Check validates that no input object has a required recursive reference
+func (r *NoRequiredRecursiveInput) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") || def.Kind != ast.InputObject {
+			continue
+		}
+
+		if !r.reachesViaRequiredFields(schema, def, def.Name, make(map[string]bool)) {
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Input type `%s` has a required recursive reference making it impossible to satisfy.", def.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// reachesViaRequiredFields runs a DFS over non-null, non-list input fields and reports whether target is reachable
+func (r *NoRequiredRecursiveInput) reachesViaRequiredFields(schema *ast.Schema, current *ast.Definition, target string, visited map[string]bool) bool {
+	visited[current.Name] = true
+
+	for _, field := range current.Fields {
+		// Nullable fields and lists (which may be empty) break the cycle
+		if !field.Type.NonNull || field.Type.Elem != nil {
+			continue
+		}
+
+		next := schema.Types[field.Type.NamedType]
+		if next == nil || next.Kind != ast.InputObject {
+			continue
+		}
+
+		if next.Name == target {
+			return true
+		}
+		if !visited[next.Name] && r.reachesViaRequiredFields(schema, next, target, visited) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,63 @@
+package rules
+
+import "testing"
+
+func TestNoRequiredRecursiveInput(t *testing.T) {
+	rule := NewNoRequiredRecursiveInput()
+
+	t.Run("should flag required self and mutual references", func(t *testing.T) {
+		schema := `
+		input Tree {
+			child: Tree!
+		}
+
+		input A {
+			b: B!
+		}
+
+		input B {
+			a: A!
+		}
+
+		type Query {
+			tree(input: Tree): Int
+			a(input: A): Int
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-required-recursive-input") != 3 {
+			t.Errorf("Expected 3 errors for required recursive inputs, got %d", countRuleErrors(errors, "no-required-recursive-input"))
+		}
+
+		expectedMessage := "Input type `Tree` has a required recursive reference making it impossible to satisfy."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should allow nullable and list recursion", func(t *testing.T) {
+		schema := `
+		input Tree {
+			child: Tree
+			children: [Tree!]!
+		}
+
+		input A {
+			b: B!
+		}
+
+		input B {
+			a: A
+		}
+
+		type Query {
+			tree(input: Tree): Int
+			a(input: A): Int
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-required-recursive-input") > 0 {
+			t.Errorf("Expected no errors for breakable recursion, got %d", countRuleErrors(errors, "no-required-recursive-input"))
+		}
+	})
+}
@@ -0,0 +1,156 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NoRequiredRecursiveOutput checks for object types reachable from Query that reference each other through required fields only
+type NoRequiredRecursiveOutput struct{}
+
+// requiredEdge is a non-null, non-list field from one object type to another
+type requiredEdge struct {
+	from  *ast.Definition
+	field *ast.FieldDefinition
+}
+
+// NewNoRequiredRecursiveOutput creates a new instance of the NoRequiredRecursiveOutput rule
+func NewNoRequiredRecursiveOutput() *NoRequiredRecursiveOutput {
+	return &NoRequiredRecursiveOutput{}
+}
+
+// Name returns the rule name
+func (r *NoRequiredRecursiveOutput) Name() string {
+	return "no-required-recursive-output"
+}
+
+// Description returns what this rule checks
+func (r *NoRequiredRecursiveOutput) Description() string {
+	return "Object types reachable from Query must not form a cycle of non-null fields, since such a response can never be fully resolved. Nullable or list recursion is allowed (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled. Required back-references such as
+// `User.account: Account!` and `Account.owner: User!` are common and resolve fine, since queries only
+// select a finite depth, so the rule is too noisy to run by default.
+func (r *NoRequiredRecursiveOutput) OptIn() bool {
+	return true
+}
+
+// Check validates that no reachable object types form a required cycle
+func (r *NoRequiredRecursiveOutput) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+	if schema.Query == nil {
+		return errors
+	}
+
+	reachable := reachableTypes(schema, schema.Query)
+	var names []string
+	for name := range reachable {
+		if def := schema.Types[name]; def.Kind == ast.Object && !def.BuiltIn && !strings.HasPrefix(name, "__") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	visited := make(map[string]bool)
+	reported := make(map[string]bool)
+	for _, name := range names {
+		if visited[name] {
+			continue
+		}
+		for _, cycle := range r.findCycles(schema, schema.Types[name], visited, make(map[string]int), nil) {
+			key := r.cycleKey(cycle)
+			if reported[key] {
+				continue
+			}
+			reported[key] = true
+			errors = append(errors, r.newError(source, cycle))
+		}
+	}
+
+	return errors
+}
+
+// findCycles runs a DFS over required object fields and returns the cycles closed by edges back into the stack.
+// onStack maps each type on the current path to its index in path.
+func (r *NoRequiredRecursiveOutput) findCycles(schema *ast.Schema, current *ast.Definition, visited map[string]bool, onStack map[string]int, path []requiredEdge) [][]requiredEdge {
+	var cycles [][]requiredEdge
+	visited[current.Name] = true
+	onStack[current.Name] = len(path)
+
+	for _, field := range current.Fields {
+		// Nullable fields and lists (which may be empty) break the cycle
+		if !field.Type.NonNull || field.Type.Elem != nil {
+			continue
+		}
+
+		next := schema.Types[field.Type.NamedType]
+		if next == nil || next.Kind != ast.Object {
+			continue
+		}
+
+		edgePath := append(path[:len(path):len(path)], requiredEdge{from: current, field: field})
+		if start, ok := onStack[next.Name]; ok {
+			cycles = append(cycles, edgePath[start:])
+			continue
+		}
+		if !visited[next.Name] {
+			cycles = append(cycles, r.findCycles(schema, next, visited, onStack, edgePath)...)
+		}
+	}
+
+	delete(onStack, current.Name)
+	return cycles
+}
+
+// cycleKey identifies a cycle regardless of the type it was entered from
+func (r *NoRequiredRecursiveOutput) cycleKey(cycle []requiredEdge) string {
+	var parts []string
+	for _, edge := range cycle {
+		parts = append(parts, edge.from.Name+"."+edge.field.Name)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// newError reports a cycle, starting from its alphabetically first type
+func (r *NoRequiredRecursiveOutput) newError(source *ast.Source, cycle []requiredEdge) types.LintError {
+	first := 0
+	for i, edge := range cycle {
+		if edge.from.Name < cycle[first].from.Name {
+			first = i
+		}
+	}
+	cycle = append(cycle[first:len(cycle):len(cycle)], cycle[:first]...)
+
+	var typeNames, edges []string
+	for _, edge := range cycle {
+		typeNames = append(typeNames, fmt.Sprintf("`%s`", edge.from.Name))
+		edges = append(edges, fmt.Sprintf("`%s.%s: %s`", edge.from.Name, edge.field.Name, edge.field.Type.String()))
+	}
+
+	subject := "Type " + typeNames[0] + " forms"
+	if len(typeNames) > 1 {
+		subject = "Types " + strings.Join(typeNames[:len(typeNames)-1], ", ") + " and " + typeNames[len(typeNames)-1] + " form"
+	}
+
+	line, column := 1, 1
+	if position := cycle[0].from.Position; position != nil {
+		line = position.Line
+		column = position.Column
+	}
+
+	return types.LintError{
+		Message: fmt.Sprintf("%s a required cyclic reference (%s).", subject, strings.Join(edges, ", ")),
+		Location: types.Location{
+			Line:   line,
+			Column: column,
+			File:   source.Name,
+		},
+		Rule: r.Name(),
+	}
+}
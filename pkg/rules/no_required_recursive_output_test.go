@@ -0,0 +1,119 @@
+package rules
+
+import "testing"
+
+func TestNoRequiredRecursiveOutput(t *testing.T) {
+	rule := NewNoRequiredRecursiveOutput()
+
+	t.Run("should flag required self and mutual references once", func(t *testing.T) {
+		schema := `
+		type Tree {
+			child: Tree!
+		}
+
+		type B {
+			a: A!
+		}
+
+		type A {
+			b: B!
+		}
+
+		type Query {
+			tree: Tree
+			b: B
+			a: A
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Type `Tree` forms a required cyclic reference (`Tree.child: Tree!`).",
+			"Types `A` and `B` form a required cyclic reference (`A.b: B!`, `B.a: A!`).",
+		}
+		if countRuleErrors(errors, "no-required-recursive-output") != len(expectedMessages) {
+			t.Errorf("Expected %d errors, got %v", len(expectedMessages), errors)
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+	})
+
+	t.Run("should allow nullable and list recursion", func(t *testing.T) {
+		schema := `
+		type User {
+			manager: User
+			reports: [User!]!
+			team: Team!
+		}
+
+		type Team {
+			lead: User
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-required-recursive-output") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should ignore cycles not reachable from Query", func(t *testing.T) {
+		schema := `
+		type A {
+			b: B!
+		}
+
+		type B {
+			c: C!
+		}
+
+		type C {
+			a: A!
+		}
+
+		type Query {
+			ping: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-required-recursive-output") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should list every type in longer cycles", func(t *testing.T) {
+		schema := `
+		type C {
+			a: A!
+		}
+
+		type A {
+			b: B!
+		}
+
+		type B {
+			c: C!
+		}
+
+		type Query {
+			c: C
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Types `A`, `B` and `C` form a required cyclic reference (`A.b: B!`, `B.c: C!`, `C.a: A!`)."
+		if countRuleErrors(errors, "no-required-recursive-output") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// minSimilarNameLength is the normalized name length below which only exact collisions are reported,
+// since short names like `Tag` and `Tax` are often one edit apart on purpose
+const minSimilarNameLength = 6
+
+// NoSimilarTypeNames checks for type names that differ only by casing, underscores or a single character
+type NoSimilarTypeNames struct{}
+
+// NewNoSimilarTypeNames creates a new instance of the NoSimilarTypeNames rule
+func NewNoSimilarTypeNames() *NoSimilarTypeNames {
+	return &NoSimilarTypeNames{}
+}
+
+// Name returns the rule name
+func (r *NoSimilarTypeNames) Name() string {
+	return "no-similar-type-names"
+}
+
+// Description returns what this rule checks
+func (r *NoSimilarTypeNames) Description() string {
+	return fmt.Sprintf("Type names should not be near-identical: names that are equal ignoring case and underscores, or that are at least %d characters long and one edit apart, are likely typos", minSimilarNameLength)
+}
+
+// Check validates that no two type names are near-identical
+func (r *NoSimilarTypeNames) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	var defs []*ast.Definition
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		defs = append(defs, def)
+	}
+
+	// Compare in source order so each pair is reported once, at its second type
+	sort.Slice(defs, func(i, j int) bool {
+		return r.offset(defs[i]) < r.offset(defs[j])
+	})
+
+	for j := range defs {
+		for i := 0; i < j; i++ {
+			if !r.isSimilar(defs[i].Name, defs[j].Name) {
+				continue
+			}
+
+			line, column := 1, 1
+			if defs[j].Position != nil {
+				line = defs[j].Position.Line
+				column = defs[j].Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Types `%s` and `%s` have near-identical names; this is likely a typo.", defs[i].Name, defs[j].Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// offset returns the position of a definition in its source; definitions without one sort first
+func (r *NoSimilarTypeNames) offset(def *ast.Definition) int {
+	if def.Position == nil {
+		return -1
+	}
+	return def.Position.Start
+}
+
+// isSimilar checks if two names collide once normalized or are a single edit apart
+func (r *NoSimilarTypeNames) isSimilar(a, b string) bool {
+	normalizedA, normalizedB := r.normalize(a), r.normalize(b)
+	if normalizedA == normalizedB {
+		return true
+	}
+	if len(normalizedA) < minSimilarNameLength || len(normalizedB) < minSimilarNameLength {
+		return false
+	}
+	return levenshteinDistance(normalizedA, normalizedB) <= 1
+}
+
+// normalize lowercases a name and strips underscores
+func (r *NoSimilarTypeNames) normalize(name string) string {
+	return strings.ReplaceAll(strings.ToLower(name), "_", "")
+}
+
+// levenshteinDistance returns the number of single-character edits needed to turn a into b
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,85 @@
+package rules
+
+import "testing"
+
+func TestNoSimilarTypeNames(t *testing.T) {
+	rule := NewNoSimilarTypeNames()
+
+	t.Run("should allow distinct type names", func(t *testing.T) {
+		schema := `
+		type Tag {
+			id: ID!
+		}
+
+		type Tax {
+			id: ID!
+		}
+
+		type UserProfile {
+			tag: Tag
+			tax: Tax
+		}
+
+		type Query {
+			profile: UserProfile
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-similar-type-names") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag names differing by casing or underscores", func(t *testing.T) {
+		schema := `
+		type UserProfile {
+			id: ID!
+		}
+
+		type Userprofile {
+			id: ID!
+		}
+
+		type User_Profile {
+			id: ID!
+		}
+
+		type Query {
+			a: UserProfile
+			b: Userprofile
+			c: User_Profile
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-similar-type-names") != 3 {
+			t.Errorf("Expected 3 errors, one per pair, got %d", countRuleErrors(errors, "no-similar-type-names"))
+		}
+
+		expectedMessage := "Types `UserProfile` and `Userprofile` have near-identical names; this is likely a typo."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should flag long names one edit apart", func(t *testing.T) {
+		schema := `
+		type Address {
+			id: ID!
+		}
+
+		type Adress {
+			id: ID!
+		}
+
+		type Query {
+			a: Address
+			b: Adress
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Types `Address` and `Adress` have near-identical names; this is likely a typo."
+		if countRuleErrors(errors, "no-similar-type-names") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
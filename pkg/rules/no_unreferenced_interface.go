@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NoUnreferencedInterface checks for implemented interfaces that are never used as a type anywhere
+type NoUnreferencedInterface struct{}
+
+// NewNoUnreferencedInterface creates a new instance of the NoUnreferencedInterface rule
+func NewNoUnreferencedInterface() *NoUnreferencedInterface {
+	return &NoUnreferencedInterface{}
+}
+
+// Name returns the rule name
+func (r *NoUnreferencedInterface) Name() string {
+	return "no-unreferenced-interface"
+}
+
+// Description returns what this rule checks
+func (r *NoUnreferencedInterface) Description() string {
+	return "Implemented interfaces should be used as a field type, argument type or union member somewhere in the schema"
+}
+
+// Check validates that every implemented interface is referenced
+func (r *NoUnreferencedInterface) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	implemented := make(map[string]bool)
+	referenced := make(map[string]bool)
+	for _, def := range schema.Types {
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		for _, name := range def.Interfaces {
+			implemented[name] = true
+		}
+		for _, name := range def.Types {
+			referenced[name] = true
+		}
+		for _, field := range def.Fields {
+			referenced[field.Type.Name()] = true
+			for _, arg := range field.Arguments {
+				referenced[arg.Type.Name()] = true
+			}
+		}
+	}
+	for _, directive := range schema.Directives {
+		for _, arg := range directive.Arguments {
+			referenced[arg.Type.Name()] = true
+		}
+	}
+
+	for _, def := range schema.Types {
+		if def.BuiltIn || def.Kind != ast.Interface {
+			continue
+		}
+		if !implemented[def.Name] || referenced[def.Name] || r.isNodeInterface(schema, def) {
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Interface `%s` is implemented but never used as a field type; consider whether it's needed.", def.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// isNodeInterface checks if the interface is the Relay `Node` interface backing a root `node` field
+func (r *NoUnreferencedInterface) isNodeInterface(schema *ast.Schema, def *ast.Definition) bool {
+	return def.Name == "Node" && schema.Query != nil && schema.Query.Fields.ForName("node") != nil
+}
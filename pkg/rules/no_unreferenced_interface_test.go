@@ -0,0 +1,94 @@
+package rules
+
+import "testing"
+
+func TestNoUnreferencedInterface(t *testing.T) {
+	rule := NewNoUnreferencedInterface()
+
+	t.Run("should allow interfaces used as field, argument or union member types", func(t *testing.T) {
+		schema := `
+		interface Named {
+			name: String!
+		}
+
+		interface Timestamped {
+			createdAt: String!
+		}
+
+		type User implements Named & Timestamped {
+			name: String!
+			createdAt: String!
+		}
+
+		union SearchResult = User
+
+		type Query {
+			named: [Named!]!
+			search: [SearchResult!]!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Interface `Timestamped` is implemented but never used as a field type; consider whether it's needed."
+		if countRuleErrors(errors, "no-unreferenced-interface") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should ignore unimplemented interfaces", func(t *testing.T) {
+		schema := `
+		interface Orphan {
+			id: ID!
+		}
+
+		type Query {
+			ping: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-unreferenced-interface") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should skip Node when a root node field exists", func(t *testing.T) {
+		schema := `
+		interface Node {
+			id: ID!
+		}
+
+		type User implements Node {
+			id: ID!
+		}
+
+		type Query {
+			node(id: ID!): User
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-unreferenced-interface") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag Node without a root node field", func(t *testing.T) {
+		schema := `
+		interface Node {
+			id: ID!
+		}
+
+		type User implements Node {
+			id: ID!
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Interface `Node` is implemented but never used as a field type; consider whether it's needed."
+		if countRuleErrors(errors, "no-unreferenced-interface") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
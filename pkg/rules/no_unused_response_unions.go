@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NoUnusedResponseUnions checks that every @responseUnion union is returned by an operation
+type NoUnusedResponseUnions struct {
+	mutationLint *MutationLint
+}
+
+// NewNoUnusedResponseUnions creates a new instance of the NoUnusedResponseUnions rule
+func NewNoUnusedResponseUnions() *NoUnusedResponseUnions {
+	return &NoUnusedResponseUnions{mutationLint: NewMutationLint()}
+}
+
+// Name returns the rule name
+func (r *NoUnusedResponseUnions) Name() string {
+	return "no-unused-response-unions"
+}
+
+// Description returns what this rule checks
+func (r *NoUnusedResponseUnions) Description() string {
+	return "Unions with @responseUnion directive must be returned by a Query or Mutation field, otherwise they are dead code"
+}
+
+// Check validates that all @responseUnion unions are reachable from an operation
+func (r *NoUnusedResponseUnions) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, unionType := range r.mutationLint.findResponseUnions(schema) {
+		if r.mutationLint.isUnionUsedInMutationOrQuery(schema, unionType.Name) {
+			continue
+		}
+
+		line, column := 1, 1
+		if unionType.Position != nil {
+			line = unionType.Position.Line
+			column = unionType.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Union `%s` has `@responseUnion` but is not returned by any operation.", unionType.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
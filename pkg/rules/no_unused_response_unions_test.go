@@ -0,0 +1,69 @@
+package rules
+
+import "testing"
+
+func TestNoUnusedResponseUnions(t *testing.T) {
+	rule := NewNoUnusedResponseUnions()
+
+	t.Run("should flag response unions not returned by any operation", func(t *testing.T) {
+		schema := `
+		directive @responseUnion on UNION
+		directive @error on OBJECT
+
+		type User {
+			id: ID!
+		}
+
+		type UserNotFound @error {
+			message: String!
+		}
+
+		union UserResult @responseUnion = User | UserNotFound
+		union DeleteUserResult @responseUnion = User | UserNotFound
+
+		type Query {
+			user(id: ID!): UserResult
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-unused-response-unions") != 1 {
+			t.Errorf("Expected 1 error for unused response union, got %d", countRuleErrors(errors, "no-unused-response-unions"))
+		}
+
+		expectedMessage := "Union `DeleteUserResult` has `@responseUnion` but is not returned by any operation."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should pass response unions returned by queries and mutations", func(t *testing.T) {
+		schema := `
+		directive @responseUnion on UNION
+		directive @error on OBJECT
+
+		type User {
+			id: ID!
+		}
+
+		type UserNotFound @error {
+			message: String!
+		}
+
+		union UserResult @responseUnion = User | UserNotFound
+		union DeleteUserResult @responseUnion = User | UserNotFound
+		union Unrelated = User | UserNotFound
+
+		type Query {
+			user(id: ID!): UserResult
+		}
+
+		type Mutation {
+			deleteUser(id: ID!): DeleteUserResult
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "no-unused-response-unions") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "no-unused-response-unions"))
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// NonNullList checks that list fields are non-null at the list level
+type NonNullList struct{}
+
+// NewNonNullList creates a new instance of the NonNullList rule
+func NewNonNullList() *NonNullList {
+	return &NonNullList{}
+}
+
+// Name returns the rule name
+func (r *NonNullList) Name() string {
+	return "non-null-list"
+}
+
+// Description returns what this rule checks
+func (r *NonNullList) Description() string {
+	return "List fields should be non-null and return an empty list for no results, since a nullable list can be either null or empty (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *NonNullList) OptIn() bool {
+	return true
+}
+
+// Check validates that list fields are non-null
+func (r *NonNullList) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			// Only the outer list is checked; list-non-null-items covers the elements
+			if field.Type.NonNull || field.Type.Elem == nil {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			listType := field.Type.String()
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s.%s` returns a nullable list `%s`; prefer a non-null list `%s!` and use an empty array for 'no results'.",
+					def.Name, field.Name, listType, listType),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
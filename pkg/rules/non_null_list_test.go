@@ -0,0 +1,57 @@
+package rules
+
+import "testing"
+
+func TestNonNullList(t *testing.T) {
+	rule := NewNonNullList()
+
+	t.Run("should allow non-null lists", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			tags: [String]!
+		}
+
+		type Query {
+			users: [User!]!
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "non-null-list") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag nullable lists", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			friends: [[User!]!]
+		}
+
+		type Query {
+			users: [User!]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Field `Query.users` returns a nullable list `[User!]`; prefer a non-null list `[User!]!` and use an empty array for 'no results'.",
+			"Field `User.friends` returns a nullable list `[[User!]!]`; prefer a non-null list `[[User!]!]!` and use an empty array for 'no results'.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "non-null-list") != 2 {
+			t.Errorf("Expected 2 errors, got %v", errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
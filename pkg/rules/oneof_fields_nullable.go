@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// OneOfFieldsNullable checks that the fields of @oneOf input objects are nullable and have no defaults
+type OneOfFieldsNullable struct{}
+
+// NewOneOfFieldsNullable creates a new instance of the OneOfFieldsNullable rule
+func NewOneOfFieldsNullable() *OneOfFieldsNullable {
+	return &OneOfFieldsNullable{}
+}
+
+// Name returns the rule name
+func (r *OneOfFieldsNullable) Name() string {
+	return "oneof-fields-nullable"
+}
+
+// Description returns what this rule checks
+func (r *OneOfFieldsNullable) Description() string {
+	return "Fields of @oneOf input objects must be nullable and have no default value, as required by the spec"
+}
+
+// Check validates the fields of @oneOf input objects
+func (r *OneOfFieldsNullable) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.InputObject || def.Directives.ForName("oneOf") == nil {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			if !field.Type.NonNull && field.DefaultValue == nil {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s.%s` in a @oneOf input must be nullable and have no default.", def.Name, field.Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
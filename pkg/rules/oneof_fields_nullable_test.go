@@ -0,0 +1,52 @@
+package rules
+
+import "testing"
+
+func TestOneOfFieldsNullable(t *testing.T) {
+	rule := NewOneOfFieldsNullable()
+
+	t.Run("should allow nullable @oneOf fields", func(t *testing.T) {
+		schema := `
+		input UserLookupInput @oneOf {
+			id: ID
+			email: String
+		}
+
+		input UserFilterInput {
+			name: String!
+			limit: Int = 10
+		}
+
+		type Query {
+			user(lookup: UserLookupInput!, filter: UserFilterInput): String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "oneof-fields-nullable") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag non-null and defaulted @oneOf fields", func(t *testing.T) {
+		schema := `
+		input UserLookupInput @oneOf {
+			id: ID!
+			email: String
+			slug: String = "me"
+		}
+
+		type Query {
+			user(lookup: UserLookupInput!): String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "oneof-fields-nullable") != 2 {
+			t.Errorf("Expected 2 errors, got %d", countRuleErrors(errors, "oneof-fields-nullable"))
+		}
+
+		expectedMessage := "Field `UserLookupInput.id` in a @oneOf input must be nullable and have no default."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+}
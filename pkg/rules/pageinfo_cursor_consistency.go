@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// PageInfoCursorConsistency checks that connections whose PageInfo exposes cursors also expose edge cursors
+type PageInfoCursorConsistency struct{}
+
+// NewPageInfoCursorConsistency creates a new instance of the PageInfoCursorConsistency rule
+func NewPageInfoCursorConsistency() *PageInfoCursorConsistency {
+	return &PageInfoCursorConsistency{}
+}
+
+// Name returns the rule name
+func (r *PageInfoCursorConsistency) Name() string {
+	return "pageinfo-cursor-consistency"
+}
+
+// Description returns what this rule checks
+func (r *PageInfoCursorConsistency) Description() string {
+	return "If a connection's PageInfo exposes startCursor/endCursor, its Edge type must expose a non-null `cursor`"
+}
+
+// Check validates cursor consistency across Connection, Edge and PageInfo types
+func (r *PageInfoCursorConsistency) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object || !strings.HasSuffix(def.Name, "Connection") {
+			continue
+		}
+
+		pageInfoField := def.Fields.ForName("pageInfo")
+		edgesField := def.Fields.ForName("edges")
+		if pageInfoField == nil || edgesField == nil {
+			continue // Missing fields are reported by relay-connection-types
+		}
+
+		pageInfo := schema.Types[pageInfoField.Type.Name()]
+		if pageInfo == nil || (pageInfo.Fields.ForName("startCursor") == nil && pageInfo.Fields.ForName("endCursor") == nil) {
+			continue
+		}
+
+		edge := schema.Types[edgesField.Type.Name()]
+		if edge == nil || edge.Kind != ast.Object {
+			continue
+		}
+
+		line, column := 1, 1
+		if edge.Position != nil {
+			line = edge.Position.Line
+			column = edge.Position.Column
+		}
+
+		var message string
+		cursorField := edge.Fields.ForName("cursor")
+		switch {
+		case cursorField == nil:
+			message = fmt.Sprintf("PageInfo for `%s` exposes cursors but `%s.cursor` is missing.", def.Name, edge.Name)
+		case !cursorField.Type.NonNull:
+			message = fmt.Sprintf("PageInfo for `%s` exposes cursors but `%s.cursor` is nullable; make it non-null.", def.Name, edge.Name)
+			if cursorField.Position != nil {
+				line = cursorField.Position.Line
+				column = cursorField.Position.Column
+			}
+		default:
+			continue
+		}
+
+		errors = append(errors, types.LintError{
+			Message: message,
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
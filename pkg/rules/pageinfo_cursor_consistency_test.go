@@ -0,0 +1,104 @@
+package rules
+
+import "testing"
+
+const cursorPageInfo = `
+	type PageInfo {
+		hasNextPage: Boolean!
+		hasPreviousPage: Boolean!
+		startCursor: String
+		endCursor: String
+	}
+`
+
+func TestPageInfoCursorConsistency(t *testing.T) {
+	rule := NewPageInfoCursorConsistency()
+
+	t.Run("should flag edges without a non-null cursor", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			pageInfo: PageInfo!
+		}
+
+		type PostEdge {
+			node: User!
+			cursor: String
+		}
+
+		type PostConnection {
+			edges: [PostEdge!]!
+			pageInfo: PageInfo!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "pageinfo-cursor-consistency") != 2 {
+			t.Errorf("Expected 2 errors for inconsistent cursors, got %d", countRuleErrors(errors, "pageinfo-cursor-consistency"))
+		}
+
+		expectedMessages := []string{
+			"PageInfo for `UserConnection` exposes cursors but `UserEdge.cursor` is missing.",
+			"PageInfo for `PostConnection` exposes cursors but `PostEdge.cursor` is nullable; make it non-null.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should pass consistent connections", func(t *testing.T) {
+		schema := cursorPageInfo + `
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+			cursor: String!
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			pageInfo: PageInfo!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "pageinfo-cursor-consistency") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "pageinfo-cursor-consistency"))
+		}
+	})
+
+	t.Run("should skip PageInfo types without cursors", func(t *testing.T) {
+		schema := `
+		type OffsetPageInfo {
+			hasNextPage: Boolean!
+		}
+
+		type User {
+			id: ID!
+		}
+
+		type UserEdge {
+			node: User!
+		}
+
+		type UserConnection {
+			edges: [UserEdge!]!
+			pageInfo: OffsetPageInfo!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "pageinfo-cursor-consistency") > 0 {
+			t.Error("Expected no errors when PageInfo has no cursors")
+		}
+	})
+}
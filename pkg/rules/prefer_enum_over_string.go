@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultEnumLikeFieldNames are the field name words used by NewPreferEnumOverString when none are configured
+var DefaultEnumLikeFieldNames = []string{"status", "type", "state", "role"}
+
+// PreferEnumOverString checks that fields naming a constrained set of values are not typed as String
+type PreferEnumOverString struct {
+	names []string
+}
+
+// NewPreferEnumOverString creates a new instance of the PreferEnumOverString rule.
+// A field matches when its last camelCase word is one of names, e.g. `paymentStatus` matches "status".
+// If names is empty, DefaultEnumLikeFieldNames is used.
+func NewPreferEnumOverString(names []string) *PreferEnumOverString {
+	if len(names) == 0 {
+		names = DefaultEnumLikeFieldNames
+	}
+	return &PreferEnumOverString{names: names}
+}
+
+// Name returns the rule name
+func (r *PreferEnumOverString) Name() string {
+	return "prefer-enum-over-string"
+}
+
+// Description returns what this rule checks
+func (r *PreferEnumOverString) Description() string {
+	return "Fields such as `status`, `type`, `state` or `role` should use an enum rather than `String` (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *PreferEnumOverString) OptIn() bool {
+	return true
+}
+
+// Check validates that enum-like fields are not typed as String
+func (r *PreferEnumOverString) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			// Enums and custom scalars are already constrained
+			if field.Type.NamedType != "String" || !contains(r.names, strings.ToLower(lastWord(field.Name))) {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s.%s` is typed `String`; consider an enum type for a constrained set of values.", def.Name, field.Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,85 @@
+package rules
+
+import "testing"
+
+func TestPreferEnumOverString(t *testing.T) {
+	t.Run("should allow enums, custom scalars and other names", func(t *testing.T) {
+		rule := NewPreferEnumOverString(nil)
+		schema := `
+		scalar MimeType
+
+		enum OrderStatus {
+			OPEN
+			CLOSED
+		}
+
+		type Order {
+			status: OrderStatus!
+			contentType: MimeType
+			statusMessage: String
+			states: [String!]
+			name: String
+		}
+
+		type Query {
+			order: Order
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "prefer-enum-over-string") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag enum-like fields typed String", func(t *testing.T) {
+		rule := NewPreferEnumOverString(nil)
+		schema := `
+		type Order {
+			status: String
+			paymentState: String!
+		}
+
+		type Query {
+			order: Order
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Field `Order.status` is typed `String`; consider an enum type for a constrained set of values.",
+			"Field `Order.paymentState` is typed `String`; consider an enum type for a constrained set of values.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "prefer-enum-over-string") != 2 {
+			t.Errorf("Expected 2 errors, got %v", errors)
+		}
+	})
+
+	t.Run("should use the configured names", func(t *testing.T) {
+		rule := NewPreferEnumOverString([]string{"currency"})
+		schema := `
+		type Order {
+			status: String
+			currency: String
+		}
+
+		type Query {
+			order: Order
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Field `Order.currency` is typed `String`; consider an enum type for a constrained set of values."
+		if countRuleErrors(errors, "prefer-enum-over-string") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !NewPreferEnumOverString(nil).OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
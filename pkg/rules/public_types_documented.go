@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// PublicTypesDocumented checks that every type clients can reach from the root operation types is documented
+type PublicTypesDocumented struct {
+	includeMutationTypes bool
+}
+
+// NewPublicTypesDocumented creates a new instance of the PublicTypesDocumented rule.
+// If includeMutationTypes is true, types only reachable from Mutation or Subscription are checked as well.
+func NewPublicTypesDocumented(includeMutationTypes bool) *PublicTypesDocumented {
+	return &PublicTypesDocumented{includeMutationTypes: includeMutationTypes}
+}
+
+// Name returns the rule name
+func (r *PublicTypesDocumented) Name() string {
+	return "public-types-documented"
+}
+
+// Description returns what this rule checks
+func (r *PublicTypesDocumented) Description() string {
+	return "Object, interface and enum types reachable from Query must have descriptions"
+}
+
+// Check validates that reachable types have descriptions
+func (r *PublicTypesDocumented) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	roots := []*ast.Definition{schema.Query}
+	if r.includeMutationTypes {
+		roots = append(roots, schema.Mutation, schema.Subscription)
+	}
+
+	// Remember the first root each type is reachable from so messages name the most public one
+	reachedFrom := make(map[string]string)
+	rootNames := make(map[string]bool)
+	for _, root := range roots {
+		if root == nil {
+			continue
+		}
+		rootNames[root.Name] = true
+		for name := range reachableTypes(schema, root) {
+			if _, ok := reachedFrom[name]; !ok {
+				reachedFrom[name] = root.Name
+			}
+		}
+	}
+
+	names := make([]string, 0, len(reachedFrom))
+	for name := range reachedFrom {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		def := schema.Types[name]
+		// Skip built-in, introspection and root operation types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") || rootNames[def.Name] {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface && def.Kind != ast.Enum {
+			continue
+		}
+		if strings.TrimSpace(def.Description) != "" {
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Public type `%s` reachable from %s is missing a description.", def.Name, reachedFrom[name]),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
@@ -0,0 +1,85 @@
+package rules
+
+import "testing"
+
+func TestPublicTypesDocumented(t *testing.T) {
+	rule := NewPublicTypesDocumented(false)
+
+	schema := `
+	type Query {
+		user(role: Role): User
+		search: SearchResult
+		node: Node
+	}
+
+	type Mutation {
+		createUser: CreateUserPayload
+	}
+
+	"A user of the platform"
+	type User {
+		address: Address
+	}
+
+	type Address {
+		zip: String
+	}
+
+	enum Role {
+		ADMIN
+	}
+
+	"Anything that can be searched"
+	union SearchResult = Post
+
+	type Post {
+		id: ID!
+	}
+
+	interface Node {
+		id: ID!
+	}
+
+	"A comment implementing Node"
+	type Comment implements Node {
+		id: ID!
+	}
+
+	type CreateUserPayload {
+		id: ID!
+	}
+
+	type Internal {
+		id: ID!
+	}
+	`
+
+	t.Run("should flag undocumented types reachable from Query", func(t *testing.T) {
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "public-types-documented") != 4 {
+			t.Errorf("Expected 4 errors for undocumented public types, got %d", countRuleErrors(errors, "public-types-documented"))
+		}
+
+		expectedMessages := []string{
+			"Public type `Address` reachable from Query is missing a description.",
+			"Public type `Role` reachable from Query is missing a description.",
+			"Public type `Post` reachable from Query is missing a description.",
+			"Public type `Node` reachable from Query is missing a description.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should include Mutation-only types when configured", func(t *testing.T) {
+		errors := runRule(t, NewPublicTypesDocumented(true), schema)
+		if countRuleErrors(errors, "public-types-documented") != 5 {
+			t.Errorf("Expected 5 errors when including mutation types, got %d", countRuleErrors(errors, "public-types-documented"))
+		}
+		if !containsError(errors, "Public type `CreateUserPayload` reachable from Mutation is missing a description.") {
+			t.Error("Expected CreateUserPayload to be flagged")
+		}
+	})
+}
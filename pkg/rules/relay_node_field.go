@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// relayNodeSignature is the root field signature required by Relay global object identification
+const relayNodeSignature = "node(id: ID!): Node"
+
+// RelayNodeField checks that Query exposes the Relay `node` field when a Node interface exists
+type RelayNodeField struct{}
+
+// NewRelayNodeField creates a new instance of the RelayNodeField rule
+func NewRelayNodeField() *RelayNodeField {
+	return &RelayNodeField{}
+}
+
+// Name returns the rule name
+func (r *RelayNodeField) Name() string {
+	return "relay-node-field"
+}
+
+// Description returns what this rule checks
+func (r *RelayNodeField) Description() string {
+	return "Ensure Query defines `node(id: ID!): Node` when a Node interface exists, following the Relay global object identification specification"
+}
+
+// Check validates the signature of the root `node` field
+func (r *RelayNodeField) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	node := schema.Types["Node"]
+	if node == nil || node.Kind != ast.Interface {
+		return errors
+	}
+
+	line, column := 1, 1
+	var field *ast.FieldDefinition
+	if schema.Query != nil {
+		if schema.Query.Position != nil {
+			line = schema.Query.Position.Line
+			column = schema.Query.Position.Column
+		}
+		field = schema.Query.Fields.ForName("node")
+	}
+
+	var problem string
+	switch {
+	case field == nil:
+		problem = "the field is missing"
+	case !r.hasRelaySignature(field):
+		problem = fmt.Sprintf("it is defined as `%s`", r.signature(field))
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+	default:
+		return errors
+	}
+
+	errors = append(errors, types.LintError{
+		Message: fmt.Sprintf("Query must define `%s` when a `Node` interface exists, but %s.", relayNodeSignature, problem),
+		Location: types.Location{
+			Line:   line,
+			Column: column,
+			File:   source.Name,
+		},
+		Rule: r.Name(),
+	})
+
+	return errors
+}
+
+// hasRelaySignature checks if a field is exactly `node(id: ID!): Node`
+func (r *RelayNodeField) hasRelaySignature(field *ast.FieldDefinition) bool {
+	return r.signature(field) == relayNodeSignature
+}
+
+// signature renders a field as `name(arg: Type, ...): Type`
+func (r *RelayNodeField) signature(field *ast.FieldDefinition) string {
+	args := make([]string, len(field.Arguments))
+	for i, arg := range field.Arguments {
+		args[i] = fmt.Sprintf("%s: %s", arg.Name, arg.Type.String())
+	}
+
+	if len(args) == 0 {
+		return fmt.Sprintf("%s: %s", field.Name, field.Type.String())
+	}
+	return fmt.Sprintf("%s(%s): %s", field.Name, strings.Join(args, ", "), field.Type.String())
+}
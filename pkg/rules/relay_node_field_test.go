@@ -0,0 +1,81 @@
+package rules
+
+import "testing"
+
+func TestRelayNodeField(t *testing.T) {
+	rule := NewRelayNodeField()
+
+	t.Run("should flag a missing node field", func(t *testing.T) {
+		schema := `
+		interface Node {
+			id: ID!
+		}
+
+		type User implements Node {
+			id: ID!
+		}
+
+		type Query {
+			user(id: ID!): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Query must define `node(id: ID!): Node` when a `Node` interface exists, but the field is missing."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should flag an incorrect node signature", func(t *testing.T) {
+		schema := `
+		interface Node {
+			id: ID!
+		}
+
+		type User implements Node {
+			id: ID!
+		}
+
+		type Query {
+			node(id: String!, type: String): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Query must define `node(id: ID!): Node` when a `Node` interface exists, but it is defined as `node(id: String!, type: String): User`."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should pass the Relay node field", func(t *testing.T) {
+		schema := `
+		interface Node {
+			id: ID!
+		}
+
+		type User implements Node {
+			id: ID!
+		}
+
+		type Query {
+			node(id: ID!): Node
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "relay-node-field") > 0 {
+			t.Error("Expected no errors for the Relay node field")
+		}
+	})
+
+	t.Run("should do nothing without a Node interface", func(t *testing.T) {
+		schema := `
+		type Query {
+			version: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "relay-node-field") > 0 {
+			t.Error("Expected no errors without a Node interface")
+		}
+	})
+}
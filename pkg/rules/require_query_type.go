@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+	"github.com/nishant-rn/gqlparser/v2/parser"
+)
+
+// RequireQueryType checks that the schema defines a Query root type with fields
+type RequireQueryType struct{}
+
+// NewRequireQueryType creates a new instance of the RequireQueryType rule
+func NewRequireQueryType() *RequireQueryType {
+	return &RequireQueryType{}
+}
+
+// Name returns the rule name
+func (r *RequireQueryType) Name() string {
+	return "require-query-type"
+}
+
+// Description returns what this rule checks
+func (r *RequireQueryType) Description() string {
+	return "The schema must define a Query root type with at least one field"
+}
+
+// Check validates a single file. A schema may declare Query in any of its files, so the
+// root type is only checked by CheckFiles.
+func (r *RequireQueryType) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	return nil
+}
+
+// CheckFiles validates that the Query root type exists and is not empty across all files
+func (r *RequireQueryType) CheckFiles(sources []*ast.Source) []types.LintError {
+	if len(sources) == 0 {
+		return nil
+	}
+
+	// The root type is named Query unless a schema definition says otherwise
+	var docs []*ast.SchemaDocument
+	queryName := "Query"
+	for _, source := range sources {
+		doc, err := parser.ParseSchema(source)
+		if err != nil {
+			continue
+		}
+		docs = append(docs, doc)
+		for _, schemaDef := range append(doc.Schema, doc.SchemaExtension...) {
+			for _, operationType := range schemaDef.OperationTypes {
+				if operationType.Operation == ast.Query {
+					queryName = operationType.Type
+				}
+			}
+		}
+	}
+
+	// Fields of the root type may be spread over its definition and extensions
+	var query *ast.Definition
+	for _, doc := range docs {
+		for _, def := range append(doc.Definitions, doc.Extensions...) {
+			if def.Name != queryName {
+				continue
+			}
+			if query == nil {
+				query = def
+			}
+			for _, field := range def.Fields {
+				if !strings.HasPrefix(field.Name, "__") {
+					return nil
+				}
+			}
+		}
+	}
+
+	if query == nil {
+		return []types.LintError{{
+			Message: fmt.Sprintf("Schema must define a non-empty `%s` root type; `%s` is missing.", queryName, queryName),
+			Location: types.Location{
+				Line:   1,
+				Column: 1,
+				File:   sources[0].Name,
+			},
+			Rule: r.Name(),
+		}}
+	}
+
+	line, column, file := 1, 1, sources[0].Name
+	if query.Position != nil {
+		line = query.Position.Line
+		column = query.Position.Column
+		if query.Position.Src != nil {
+			file = query.Position.Src.Name
+		}
+	}
+
+	return []types.LintError{{
+		Message: fmt.Sprintf("Schema must define a non-empty `%s` root type; `%s` has no fields.", queryName, queryName),
+		Location: types.Location{
+			Line:   line,
+			Column: column,
+			File:   file,
+		},
+		Rule: r.Name(),
+	}}
+}
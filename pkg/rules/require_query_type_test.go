@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+func TestRequireQueryType(t *testing.T) {
+	rule := NewRequireQueryType()
+
+	t.Run("should allow a Query type with fields", func(t *testing.T) {
+		schema := `
+		type Query {
+			version: String
+		}
+		`
+		errors := rule.CheckFiles([]*ast.Source{{Name: "schema.graphql", Input: schema}})
+		if countRuleErrors(errors, "require-query-type") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should not check single files", func(t *testing.T) {
+		errors := runRule(t, rule, `type User { id: ID! }`)
+		if countRuleErrors(errors, "require-query-type") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should find Query in any file", func(t *testing.T) {
+		sources := []*ast.Source{
+			{Name: "a.graphql", Input: `type Query { user: User }`},
+			{Name: "b.graphql", Input: `type User { id: ID! }`},
+		}
+		errors := rule.CheckFiles(sources)
+		if len(errors) > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag a missing Query type", func(t *testing.T) {
+		sources := []*ast.Source{
+			{Name: "users.graphql", Input: `type User { id: ID! }`},
+			{Name: "mutations.graphql", Input: `type Mutation { createUser: User }`},
+		}
+		errors := rule.CheckFiles(sources)
+		expectedMessage := "Schema must define a non-empty `Query` root type; `Query` is missing."
+		if countRuleErrors(errors, "require-query-type") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should use the query type named by the schema definition", func(t *testing.T) {
+		sources := []*ast.Source{
+			{Name: "schema.graphql", Input: `schema { query: Root }`},
+			{Name: "root.graphql", Input: `type Root { version: String }`},
+		}
+		errors := rule.CheckFiles(sources)
+		if len(errors) > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag a Query type without fields", func(t *testing.T) {
+		sources := []*ast.Source{
+			{Name: "users.graphql", Input: `type User { id: ID! }`},
+			{Name: "query.graphql", Input: "\ntype Query"},
+		}
+		errors := rule.CheckFiles(sources)
+		expectedMessage := "Schema must define a non-empty `Query` root type; `Query` has no fields."
+		if countRuleErrors(errors, "require-query-type") != 1 || !containsError(errors, expectedMessage) {
+			t.Fatalf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+		if errors[0].Location.File != "query.graphql" || errors[0].Location.Line != 2 {
+			t.Errorf("Expected the error at query.graphql:2, got %+v", errors[0].Location)
+		}
+	})
+}
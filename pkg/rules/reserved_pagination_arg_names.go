@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// reservedPaginationArgTypes maps the Relay pagination argument names to the types they should have
+var reservedPaginationArgTypes = map[string]string{
+	"first":  "Int",
+	"last":   "Int",
+	"after":  "String",
+	"before": "String",
+}
+
+// ReservedPaginationArgNames checks that Relay pagination argument names keep their pagination meaning outside connections
+type ReservedPaginationArgNames struct{}
+
+// NewReservedPaginationArgNames creates a new instance of the ReservedPaginationArgNames rule
+func NewReservedPaginationArgNames() *ReservedPaginationArgNames {
+	return &ReservedPaginationArgNames{}
+}
+
+// Name returns the rule name
+func (r *ReservedPaginationArgNames) Name() string {
+	return "reserved-pagination-arg-names"
+}
+
+// Description returns what this rule checks
+func (r *ReservedPaginationArgNames) Description() string {
+	return "Fields not returning Connection types should only use the Relay pagination argument names first, last, after and before to paginate lists, with Int counts and String cursors. Connection fields are checked by relay-arguments"
+}
+
+// Check validates the use of reserved pagination argument names
+func (r *ReservedPaginationArgNames) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Connection fields are covered by relay-arguments
+			if strings.HasSuffix(strings.ToLower(field.Type.Name()), "connection") {
+				continue
+			}
+
+			for _, arg := range field.Arguments {
+				expectedType, reserved := reservedPaginationArgTypes[arg.Name]
+				if !reserved {
+					continue
+				}
+
+				var message string
+				switch {
+				case arg.Type.NamedType != expectedType && !r.isCursorType(arg, expectedType):
+					message = fmt.Sprintf("Argument `%s` on `%s.%s` collides with the reserved Relay pagination name but is typed `%s`.", arg.Name, def.Name, field.Name, arg.Type.String())
+				case !isListType(field.Type):
+					message = fmt.Sprintf("Argument `%s` on `%s.%s` collides with the reserved Relay pagination name but `%s.%s` does not return a list or connection.", arg.Name, def.Name, field.Name, def.Name, field.Name)
+				default:
+					continue
+				}
+
+				line, column := 1, 1
+				if arg.Position != nil {
+					line = arg.Position.Line
+					column = arg.Position.Column
+				}
+
+				errors = append(errors, types.LintError{
+					Message: message,
+					Location: types.Location{
+						Line:   line,
+						Column: column,
+						File:   source.Name,
+					},
+					Rule: r.Name(),
+				})
+			}
+		}
+	}
+
+	return errors
+}
+
+// isCursorType checks if a cursor argument uses a dedicated cursor scalar, e.g. `after: Cursor`
+func (r *ReservedPaginationArgNames) isCursorType(arg *ast.ArgumentDefinition, expectedType string) bool {
+	return expectedType == "String" && arg.Type.NamedType != "" && strings.HasSuffix(arg.Type.NamedType, "Cursor")
+}
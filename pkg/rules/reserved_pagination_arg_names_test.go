@@ -0,0 +1,69 @@
+package rules
+
+import "testing"
+
+func TestReservedPaginationArgNames(t *testing.T) {
+	rule := NewReservedPaginationArgNames()
+
+	t.Run("should allow pagination arguments on lists and connections", func(t *testing.T) {
+		schema := `
+		scalar Cursor
+
+		type User {
+			id: ID!
+		}
+
+		type UserConnection {
+			nodes: [User!]!
+		}
+
+		type Query {
+			users(first: Int, after: String): [User!]!
+			recentUsers(last: Int!, before: Cursor): [User!]!
+			userConnection(first: String): UserConnection!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "reserved-pagination-arg-names") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag pagination arguments with the wrong type", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type Query {
+			search(first: String, after: Int): [User!]!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "reserved-pagination-arg-names") != 2 {
+			t.Errorf("Expected 2 errors, got %d", countRuleErrors(errors, "reserved-pagination-arg-names"))
+		}
+
+		expectedMessage := "Argument `first` on `Query.search` collides with the reserved Relay pagination name but is typed `String`."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should flag pagination arguments on single-value fields", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type Query {
+			user(first: Int): User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Argument `first` on `Query.user` collides with the reserved Relay pagination name but `Query.user` does not return a list or connection."
+		if countRuleErrors(errors, "reserved-pagination-arg-names") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// RootFieldsHaveDescriptions checks that Query, Mutation and Subscription fields have descriptions
+type RootFieldsHaveDescriptions struct{}
+
+// NewRootFieldsHaveDescriptions creates a new instance of the RootFieldsHaveDescriptions rule
+func NewRootFieldsHaveDescriptions() *RootFieldsHaveDescriptions {
+	return &RootFieldsHaveDescriptions{}
+}
+
+// Name returns the rule name
+func (r *RootFieldsHaveDescriptions) Name() string {
+	return "root-fields-have-descriptions"
+}
+
+// Description returns what this rule checks
+func (r *RootFieldsHaveDescriptions) Description() string {
+	return "Fields on the Query, Mutation and Subscription root types must have descriptions"
+}
+
+// Check validates that all root fields have descriptions
+func (r *RootFieldsHaveDescriptions) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, root := range []*ast.Definition{schema.Query, schema.Mutation, schema.Subscription} {
+		// Absent root types have nothing to check
+		if root == nil {
+			continue
+		}
+
+		for _, field := range root.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			if strings.TrimSpace(field.Description) != "" {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Root field `%s.%s` must have a description.", root.Name, field.Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,61 @@
+package rules
+
+import "testing"
+
+func TestRootFieldsHaveDescriptions(t *testing.T) {
+	rule := NewRootFieldsHaveDescriptions()
+
+	t.Run("should allow documented root fields", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type Query {
+			"""Lists all users."""
+			users: [User!]!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "root-fields-have-descriptions") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag undocumented root fields only", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type Query {
+			users: [User!]!
+		}
+
+		type Mutation {
+			"""Creates a user."""
+			createUser: User
+			deleteUser: User
+		}
+
+		type Subscription {
+			userCreated: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "root-fields-have-descriptions") != 3 {
+			t.Errorf("Expected 3 errors for undocumented root fields, got %d", countRuleErrors(errors, "root-fields-have-descriptions"))
+		}
+
+		expectedMessages := []string{
+			"Root field `Query.users` must have a description.",
+			"Root field `Mutation.deleteUser` must have a description.",
+			"Root field `Subscription.userCreated` must have a description.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+}
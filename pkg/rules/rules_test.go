@@ -128,9 +128,142 @@ func TestNoHashtagDescription(t *testing.T) {
 	})
 }
 
+func TestNoHashtagDescriptionFix(t *testing.T) {
+	rule := NewNoHashtagDescription()
+
+	t.Run("should convert comments above definitions into descriptions", func(t *testing.T) {
+		input := `# A user of the platform
+type User {
+  # Unique identifier.
+  # Never reused.
+  id: ID!
+  name: String # display name
+  # gqllinter-ignore
+  email: String
+}
+`
+		expected := `"""A user of the platform"""
+type User {
+  """
+  Unique identifier.
+  Never reused.
+  """
+  id: ID!
+  name: String # display name
+  # gqllinter-ignore
+  email: String
+}
+`
+		fixed, err := rule.Fix(&ast.Source{Name: "schema.graphql", Input: input})
+		if err != nil {
+			t.Fatalf("Fix failed: %v", err)
+		}
+		if fixed != expected {
+			t.Errorf("Unexpected fix result:\n%s", fixed)
+		}
+
+		schema, source := parseSchema(t, fixed)
+		if countRuleErrors(rule.Check(schema, source), "no-hashtag-description") > 0 {
+			t.Error("Expected no errors after fixing")
+		}
+	})
+
+	t.Run("should skip comments that can't become descriptions", func(t *testing.T) {
+		input := `schema {
+  # root query
+  query: Query
+}
+
+type Query {
+  "Existing description"
+  # implementation note
+  version: String
+}
+`
+		fixed, err := rule.Fix(&ast.Source{Name: "schema.graphql", Input: input})
+		if err != nil {
+			t.Fatalf("Fix failed: %v", err)
+		}
+		if fixed != input {
+			t.Errorf("Expected source to be unchanged, got:\n%s", fixed)
+		}
+	})
+
+	t.Run("should skip bare comment lines", func(t *testing.T) {
+		input := "type Query {\n  #\n  version: String\n  #\n  # The build\n  #\n  build: String\n}\n"
+		expected := "type Query {\n  #\n  version: String\n  \"\"\"The build\"\"\"\n  build: String\n}\n"
+		fixed, err := rule.Fix(&ast.Source{Name: "schema.graphql", Input: input})
+		if err != nil {
+			t.Fatalf("Fix failed: %v", err)
+		}
+		if fixed != expected {
+			t.Errorf("Unexpected fix result:\n%s", fixed)
+		}
+
+		schema, source := parseSchema(t, input)
+		if countRuleErrors(rule.Check(schema, source), "no-hashtag-description") != 1 {
+			t.Error("Expected only the comment with text to be reported")
+		}
+	})
+}
+
 func TestNamingConvention(t *testing.T) {
 	rule := NewNamingConvention()
 
+	t.Run("should span the offending name", func(t *testing.T) {
+		schema := `type Query {
+	user_name: String
+}`
+		errors := runRule(t, rule, schema)
+		expected := types.Location{Line: 2, Column: 2, EndLine: 2, EndColumn: 10, File: "test.graphql"}
+		if len(errors) != 1 || errors[0].Location != expected {
+			t.Errorf("Expected location %+v, got %v", expected, errors)
+		}
+	})
+
+	t.Run("should span the name of documented members", func(t *testing.T) {
+		schema := `type Query {
+	"Some doc about user_name."
+	user_name: String
+	"""
+	Block doc.
+	"""
+	# note
+	other_name: String
+}
+enum Status {
+	"Doc" active
+}`
+		errors := runRule(t, rule, schema)
+		expected := map[string]types.Location{
+			"Field name `Query.user_name` should be camelCase.":  {Line: 3, Column: 2, EndLine: 3, EndColumn: 10, File: "test.graphql"},
+			"Field name `Query.other_name` should be camelCase.": {Line: 8, Column: 2, EndLine: 8, EndColumn: 11, File: "test.graphql"},
+			"Enum value `Status.active` should be UPPER_CASE":    {Line: 11, Column: 8, EndLine: 11, EndColumn: 13, File: "test.graphql"},
+		}
+		if len(errors) != len(expected) {
+			t.Errorf("Expected %d errors, got %v", len(expected), errors)
+		}
+		for _, err := range errors {
+			if location, ok := expected[err.Message]; !ok || err.Location != location {
+				t.Errorf("Expected location %+v for %q, got %+v", location, err.Message, err.Location)
+			}
+		}
+	})
+
+	t.Run("should span names after non-ASCII text", func(t *testing.T) {
+		schema := `"""Café — root"""
+type Query {
+  "Prix en €"
+  Bad_name: String
+}`
+		errors := runRule(t, rule, schema)
+		expected := types.Location{Line: 4, Column: 3, EndLine: 4, EndColumn: 10, File: "test.graphql"}
+		message := "Field name `Query.Bad_name` should be camelCase."
+		if len(errors) != 1 || errors[0].Message != message || errors[0].Location != expected {
+			t.Errorf("Expected %q at %+v, got %v", message, expected, errors)
+		}
+	})
+
 	t.Run("Type Names - PascalCase Validation", func(t *testing.T) {
 		t.Run("should flag non-PascalCase type names", func(t *testing.T) {
 			schema := `
@@ -690,6 +823,99 @@ func TestAlphabetize(t *testing.T) {
 			t.Error("Expected no alphabetize errors for ordered fields")
 		}
 	})
+
+	t.Run("should span the type name", func(t *testing.T) {
+		schema := `type User {
+			name: String!
+			id: ID!
+		}`
+		errors := runRule(t, rule, schema)
+		expected := types.Location{Line: 1, Column: 6, EndLine: 1, EndColumn: 9, File: "test.graphql"}
+		if len(errors) != 1 || errors[0].Location != expected {
+			t.Errorf("Expected location %+v, got %v", expected, errors)
+		}
+	})
+}
+
+func TestAlphabetizeFix(t *testing.T) {
+	rule := NewAlphabetize()
+
+	t.Run("should sort fields and enum values keeping attached text", func(t *testing.T) {
+		input := `type User {
+  "The user's name"
+  name: String! @deprecated(reason: "Use { fullName }")
+  # Primary key
+  id: ID!
+
+  email(
+    format: String
+  ): String
+}
+
+enum Status { PENDING ACTIVE }
+
+enum Role {
+  MEMBER
+  ADMIN
+}
+`
+		expected := `type User {
+  email(
+    format: String
+  ): String
+  # Primary key
+  id: ID!
+
+  "The user's name"
+  name: String! @deprecated(reason: "Use { fullName }")
+}
+
+enum Status { ACTIVE PENDING }
+
+enum Role {
+  ADMIN
+  MEMBER
+}
+`
+		fixed, err := rule.Fix(&ast.Source{Name: "schema.graphql", Input: input})
+		if err != nil {
+			t.Fatalf("Fix failed: %v", err)
+		}
+		if fixed != expected {
+			t.Errorf("Unexpected fix result:\n%s", fixed)
+		}
+
+		again, err := rule.Fix(&ast.Source{Name: "schema.graphql", Input: fixed})
+		if err != nil {
+			t.Fatalf("Fix failed: %v", err)
+		}
+		if again != fixed {
+			t.Error("Expected fixing twice to be idempotent")
+		}
+	})
+
+	t.Run("should keep trailing comments with the member before them", func(t *testing.T) {
+		input := "type Query {\n  b: String # note\n  a: String\n}\n\nenum Status { PENDING # waiting\n  ACTIVE }\n"
+		expected := "type Query {\n  a: String\n  b: String # note\n}\n\nenum Status { ACTIVE\n  PENDING # waiting\n}\n"
+		fixed, err := rule.Fix(&ast.Source{Name: "schema.graphql", Input: input})
+		if err != nil {
+			t.Fatalf("Fix failed: %v", err)
+		}
+		if fixed != expected {
+			t.Errorf("Unexpected fix result:\n%s", fixed)
+		}
+	})
+
+	t.Run("should leave ordered sources unchanged", func(t *testing.T) {
+		input := "type User {\n  email: String\n  id: ID!\n}\n"
+		fixed, err := rule.Fix(&ast.Source{Name: "schema.graphql", Input: input})
+		if err != nil {
+			t.Fatalf("Fix failed: %v", err)
+		}
+		if fixed != input {
+			t.Errorf("Expected source to be unchanged, got:\n%s", fixed)
+		}
+	})
 }
 
 func TestInputName(t *testing.T) {
@@ -1007,6 +1233,49 @@ func TestCapitalizedDescriptions(t *testing.T) {
 			t.Error("Expected no capitalization errors for proper descriptions")
 		}
 	})
+
+	t.Run("should flag lowercase enum value and argument descriptions", func(t *testing.T) {
+		schema := `
+		enum Status {
+			"""currently active"""
+			ACTIVE
+		}
+
+		type Query {
+			users("""number of users""" first: Int): [String!]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Description for enum value `Status.ACTIVE` should start with a capital letter.",
+			"Description for argument `Query.users(first:)` should start with a capital letter.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should pass descriptions starting with code, numbers or non-ASCII capitals", func(t *testing.T) {
+		schema := `
+		enum Status {
+			"""` + "`ACTIVE`" + ` users can sign in"""
+			ACTIVE
+		}
+
+		type User {
+			"""3 letter country code"""
+			country: String
+			"""Émile's field"""
+			name: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "capitalized-descriptions") > 0 {
+			t.Errorf("Expected no capitalization errors, got %v", errors)
+		}
+	})
 }
 
 func TestEnumUnknownCase(t *testing.T) {
@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// collectionSuffixes are name suffixes that already describe a collection, e.g. `tagSet`
+var collectionSuffixes = []string{"List", "Set", "Collection", "Array", "Map"}
+
+// ScalarListPluralization checks that list fields of scalars and enums have plural names
+type ScalarListPluralization struct {
+	exempt  map[string]bool
+	plurals pluralDictionary
+}
+
+// NewScalarListPluralization creates a new instance of the ScalarListPluralization rule.
+// Field names in exemptFields are known scalar collections that may keep singular names.
+func NewScalarListPluralization(exemptFields []string) *ScalarListPluralization {
+	exempt := make(map[string]bool)
+	for _, name := range exemptFields {
+		exempt[name] = true
+	}
+	return &ScalarListPluralization{exempt: exempt, plurals: defaultPlurals}
+}
+
+// Name returns the rule name
+func (r *ScalarListPluralization) Name() string {
+	return "scalar-list-pluralization"
+}
+
+// Description returns what this rule checks
+func (r *ScalarListPluralization) Description() string {
+	return "List fields whose items are scalars or enums should have plural names, except for known scalar collections"
+}
+
+// Check validates that scalar and enum list fields are plural
+func (r *ScalarListPluralization) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface && def.Kind != ast.InputObject {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection and exempt fields
+			if strings.HasPrefix(field.Name, "__") || r.exempt[field.Name] {
+				continue
+			}
+
+			if !isListType(field.Type) || !r.hasScalarItems(schema, field.Type) {
+				continue
+			}
+
+			if r.plurals.isPlural(field.Name) || isCollectionName(field.Name) {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Scalar-list field `%s.%s` should be plural (`%s`).", def.Name, field.Name, r.plurals.pluralize(field.Name)),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// hasScalarItems checks if the innermost item type of a list is a scalar or enum
+func (r *ScalarListPluralization) hasScalarItems(schema *ast.Schema, fieldType *ast.Type) bool {
+	itemType := schema.Types[fieldType.Name()]
+	return itemType != nil && (itemType.Kind == ast.Scalar || itemType.Kind == ast.Enum)
+}
+
+// isCollectionName checks if a field name already ends with a collection word
+func isCollectionName(name string) bool {
+	for _, suffix := range collectionSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
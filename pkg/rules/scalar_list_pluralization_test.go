@@ -0,0 +1,75 @@
+package rules
+
+import "testing"
+
+func TestScalarListPluralization(t *testing.T) {
+	rule := NewScalarListPluralization(nil)
+
+	t.Run("should flag singular scalar and enum list fields", func(t *testing.T) {
+		schema := `
+		enum Role {
+			ADMIN
+			MEMBER
+		}
+
+		type User {
+			permission: [String!]!
+			role: [Role!]!
+			flag: [Boolean!]
+			category: [String!]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "scalar-list-pluralization") != 4 {
+			t.Errorf("Expected 4 errors for singular scalar lists, got %d", countRuleErrors(errors, "scalar-list-pluralization"))
+		}
+
+		expectedMessages := []string{
+			"Scalar-list field `User.permission` should be plural (`permissions`).",
+			"Scalar-list field `User.role` should be plural (`roles`).",
+			"Scalar-list field `User.category` should be plural (`categories`).",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should pass plural, collection-named and object lists", func(t *testing.T) {
+		schema := `
+		enum Role {
+			ADMIN
+		}
+
+		type Friend {
+			id: ID!
+		}
+
+		type User {
+			roles: [Role!]!
+			permissions: [String!]!
+			tagSet: [String!]
+			metadata: [String!]
+			friend: [Friend!]
+			name: String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "scalar-list-pluralization") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "scalar-list-pluralization"))
+		}
+	})
+
+	t.Run("should skip configured scalar collections", func(t *testing.T) {
+		schema := `
+		type Point {
+			coordinate: [Float!]!
+		}
+		`
+		errors := runRule(t, NewScalarListPluralization([]string{"coordinate"}), schema)
+		if countRuleErrors(errors, "scalar-list-pluralization") > 0 {
+			t.Error("Expected no errors for exempt fields")
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultSingleEntityQueryExemptions are the query fields allowed to return a single entity without arguments
+var DefaultSingleEntityQueryExemptions = []string{"viewer", "me", "currentUser"}
+
+// SingleEntityQueryNeedsArg checks that query fields returning a single entity accept an identifying argument
+type SingleEntityQueryNeedsArg struct {
+	exempt map[string]bool
+}
+
+// NewSingleEntityQueryNeedsArg creates a new instance of the SingleEntityQueryNeedsArg rule.
+// If exemptFields is empty, DefaultSingleEntityQueryExemptions is used.
+func NewSingleEntityQueryNeedsArg(exemptFields []string) *SingleEntityQueryNeedsArg {
+	if len(exemptFields) == 0 {
+		exemptFields = DefaultSingleEntityQueryExemptions
+	}
+	exempt := make(map[string]bool)
+	for _, name := range exemptFields {
+		exempt[name] = true
+	}
+	return &SingleEntityQueryNeedsArg{exempt: exempt}
+}
+
+// Name returns the rule name
+func (r *SingleEntityQueryNeedsArg) Name() string {
+	return "single-entity-query-needs-arg"
+}
+
+// Description returns what this rule checks
+func (r *SingleEntityQueryNeedsArg) Description() string {
+	return "Query fields returning a single object type should accept an identifying argument, except for exempt fields like viewer or me"
+}
+
+// Check validates that single-entity query fields take at least one argument
+func (r *SingleEntityQueryNeedsArg) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	if schema.Query == nil {
+		return errors
+	}
+
+	for _, field := range schema.Query.Fields {
+		// Skip introspection fields and exempt fields
+		if strings.HasPrefix(field.Name, "__") || r.exempt[field.Name] {
+			continue
+		}
+
+		if len(field.Arguments) > 0 || field.Type.Elem != nil {
+			continue
+		}
+
+		returnType := schema.Types[field.Type.Name()]
+		if returnType == nil || returnType.Kind != ast.Object || strings.HasSuffix(returnType.Name, "Connection") {
+			continue
+		}
+
+		line, column := 1, 1
+		if field.Position != nil {
+			line = field.Position.Line
+			column = field.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Query field `%s.%s` returns a single `%s` but takes no arguments; add an identifying argument like `id: ID!`.", schema.Query.Name, field.Name, returnType.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
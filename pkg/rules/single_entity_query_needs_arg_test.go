@@ -0,0 +1,78 @@
+package rules
+
+import "testing"
+
+func TestSingleEntityQueryNeedsArg(t *testing.T) {
+	rule := NewSingleEntityQueryNeedsArg(nil)
+
+	t.Run("should flag single-entity query fields without arguments", func(t *testing.T) {
+		schema := `
+		type Query {
+			user: User
+			post: Post!
+		}
+
+		type User {
+			id: ID!
+		}
+
+		type Post {
+			id: ID!
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "single-entity-query-needs-arg") != 2 {
+			t.Errorf("Expected 2 errors, got %d", countRuleErrors(errors, "single-entity-query-needs-arg"))
+		}
+
+		expectedMessage := "Query field `Query.user` returns a single `User` but takes no arguments; add an identifying argument like `id: ID!`."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should skip arguments, scalars, lists, connections and exempt fields", func(t *testing.T) {
+		schema := `
+		type Query {
+			user(id: ID!): User
+			users: [User!]
+			version: String
+			userConnection: UserConnection
+			viewer: User
+			me: User
+		}
+
+		type User {
+			id: ID!
+		}
+
+		type UserConnection {
+			nodes: [User!]
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "single-entity-query-needs-arg") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "single-entity-query-needs-arg"))
+		}
+	})
+
+	t.Run("should use configured exemptions", func(t *testing.T) {
+		schema := `
+		type Query {
+			session: Session
+			viewer: Session
+		}
+
+		type Session {
+			id: ID!
+		}
+		`
+		errors := runRule(t, NewSingleEntityQueryNeedsArg([]string{"session"}), schema)
+		if countRuleErrors(errors, "single-entity-query-needs-arg") != 1 {
+			t.Errorf("Expected 1 error with custom exemptions, got %d", countRuleErrors(errors, "single-entity-query-needs-arg"))
+		}
+		if !containsError(errors, "Query field `Query.viewer` returns a single `Session` but takes no arguments; add an identifying argument like `id: ID!`.") {
+			t.Error("Expected viewer to be flagged when not in the exemption list")
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// SingleFieldsSingular checks that fields returning a single object have singular names
+type SingleFieldsSingular struct {
+	plurals pluralDictionary
+}
+
+// NewSingleFieldsSingular creates a new instance of the SingleFieldsSingular rule.
+// irregularPlurals maps lowercase singular words to their plurals and uncountableWords lists
+// nouns that may look plural; DefaultIrregularPlurals and DefaultUncountableWords are used when empty.
+func NewSingleFieldsSingular(irregularPlurals map[string]string, uncountableWords []string) *SingleFieldsSingular {
+	return &SingleFieldsSingular{plurals: newPluralDictionary(irregularPlurals, uncountableWords)}
+}
+
+// Name returns the rule name
+func (r *SingleFieldsSingular) Name() string {
+	return "single-fields-singular"
+}
+
+// Description returns what this rule checks
+func (r *SingleFieldsSingular) Description() string {
+	return "Fields returning a single object, interface or union should not have plural names, unless the noun is uncountable or the returned type is itself named plurally or a Connection"
+}
+
+// Check validates that single-entity fields are named singularly
+func (r *SingleFieldsSingular) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+
+		// Mutation and subscription fields name operations, not entities
+		if def == schema.Mutation || def == schema.Subscription {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			returnType := schema.Types[field.Type.Name()]
+			if isListType(field.Type) || returnType == nil {
+				continue
+			}
+			if returnType.Kind != ast.Object && returnType.Kind != ast.Interface && returnType.Kind != ast.Union {
+				continue
+			}
+			// Connections are paginated collections, e.g. `orders: OrderConnection!`
+			if isPaginationHelperType(returnType.Name) {
+				continue
+			}
+
+			if !r.plurals.isPlural(field.Name) || r.plurals.isUncountable(field.Name) {
+				continue
+			}
+
+			// A plural field can match a plurally named type, e.g. `settings: UserSettings`
+			if r.plurals.isPlural(returnType.Name) {
+				continue
+			}
+
+			line, column := 1, 1
+			if field.Position != nil {
+				line = field.Position.Line
+				column = field.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Field `%s.%s` returns a single `%s` but is named plurally.", def.Name, field.Name, returnType.Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,131 @@
+package rules
+
+import "testing"
+
+func TestSingleFieldsSingular(t *testing.T) {
+	t.Run("should allow singular, uncountable and plural-type fields", func(t *testing.T) {
+		rule := NewSingleFieldsSingular(nil, nil)
+		schema := `
+		type Item {
+			id: ID!
+		}
+
+		type UserSettings {
+			theme: String
+		}
+
+		type Order {
+			item: Item
+			items: [Item!]
+			info: Item
+			data: Item
+			settings: UserSettings
+			status: Item
+		}
+
+		type Mutation {
+			archiveItems: Item
+		}
+
+		type Query {
+			order: Order
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "single-fields-singular") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag plural single-entity fields", func(t *testing.T) {
+		rule := NewSingleFieldsSingular(nil, nil)
+		schema := `
+		type Item {
+			id: ID!
+		}
+
+		type Person {
+			id: ID!
+		}
+
+		type Order {
+			items: Item
+			people: Person
+		}
+
+		type Query {
+			order: Order
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "single-fields-singular") != 2 {
+			t.Errorf("Expected 2 errors for plural fields, got %d", countRuleErrors(errors, "single-fields-singular"))
+		}
+
+		expectedMessage := "Field `Order.items` returns a single `Item` but is named plurally."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should exempt configured uncountable words", func(t *testing.T) {
+		rule := NewSingleFieldsSingular(nil, []string{"analytics"})
+		schema := `
+		type Report {
+			id: ID!
+		}
+
+		type Query {
+			analytics: Report
+			reports: Report
+			news: Report
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Field `Query.reports` returns a single `Report` but is named plurally.",
+			// The configured list replaces the default uncountable words
+			"Field `Query.news` returns a single `Report` but is named plurally.",
+		}
+		if countRuleErrors(errors, "single-fields-singular") != len(expectedMessages) {
+			t.Errorf("Expected %d errors, got %v", len(expectedMessages), errors)
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+	})
+
+	t.Run("should allow plural fields returning a connection", func(t *testing.T) {
+		rule := NewSingleFieldsSingular(nil, nil)
+		schema := cursorPageInfo + `
+		type Order {
+			id: ID!
+		}
+
+		type OrderEdge {
+			node: Order!
+			cursor: String!
+		}
+
+		type OrderConnection {
+			edges: [OrderEdge!]!
+			pageInfo: PageInfo!
+		}
+
+		type User {
+			orders: OrderConnection!
+		}
+
+		type Query {
+			orders: OrderConnection!
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "single-fields-singular") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// SingleIdentifierPerType checks that object types have a single canonical identifier field
+type SingleIdentifierPerType struct{}
+
+// NewSingleIdentifierPerType creates a new instance of the SingleIdentifierPerType rule
+func NewSingleIdentifierPerType() *SingleIdentifierPerType {
+	return &SingleIdentifierPerType{}
+}
+
+// Name returns the rule name
+func (r *SingleIdentifierPerType) Name() string {
+	return "single-identifier-per-type"
+}
+
+// Description returns what this rule checks
+func (r *SingleIdentifierPerType) Description() string {
+	return "Object types should have a single identifier field (`id`, `*Id`, `uuid`, `guid`); foreign keys named after another type, like `userId`, are exempt"
+}
+
+// Check validates that each object type has at most one identifier field
+func (r *SingleIdentifierPerType) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Object {
+			continue
+		}
+
+		var identifiers []string
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+			if r.isIdentifierField(field.Name) && !r.isForeignKey(schema, def, field.Name) {
+				identifiers = append(identifiers, "`"+field.Name+"`")
+			}
+		}
+
+		if len(identifiers) < 2 {
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Type `%s` has multiple identifier fields (%s); prefer a single canonical identifier.", def.Name, strings.Join(identifiers, ", ")),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// isIdentifierField checks if a field name looks like a single identifier
+func (r *SingleIdentifierPerType) isIdentifierField(name string) bool {
+	switch name {
+	case "id", "uuid", "guid":
+		return true
+	}
+	return strings.HasSuffix(name, "Id") || strings.HasSuffix(name, "ID")
+}
+
+// isForeignKey checks if an identifier field is named after another type, e.g. `userId` referencing `User`
+func (r *SingleIdentifierPerType) isForeignKey(schema *ast.Schema, owner *ast.Definition, name string) bool {
+	prefix := strings.TrimSuffix(strings.TrimSuffix(name, "Id"), "ID")
+	if prefix == "" || prefix == name {
+		return false
+	}
+	typeName := strings.ToUpper(prefix[:1]) + prefix[1:]
+	return typeName != owner.Name && schema.Types[typeName] != nil
+}
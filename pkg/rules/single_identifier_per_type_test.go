@@ -0,0 +1,54 @@
+package rules
+
+import "testing"
+
+func TestSingleIdentifierPerType(t *testing.T) {
+	rule := NewSingleIdentifierPerType()
+
+	t.Run("should allow a single identifier and foreign keys", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type Team {
+			id: ID!
+		}
+
+		type Post {
+			id: ID!
+			userId: ID!
+			teamID: ID
+		}
+
+		type Query {
+			post: Post
+			team: Team
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "single-identifier-per-type") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag types with several identifiers", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+			uuid: ID!
+			externalId: String
+			userId: ID
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Type `User` has multiple identifier fields (`id`, `uuid`, `externalId`, `userId`); prefer a single canonical identifier."
+		if countRuleErrors(errors, "single-identifier-per-type") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+}
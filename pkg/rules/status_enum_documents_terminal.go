@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultStatusEnumSuffixes are the enum name suffixes treated as lifecycle enums when none are configured
+var DefaultStatusEnumSuffixes = []string{"Status", "State"}
+
+// terminalStateKeywords are the words that indicate a description documents terminal states
+var terminalStateKeywords = []string{"terminal", "final"}
+
+// likelyTerminalValues are enum value words commonly used for terminal lifecycle states
+var likelyTerminalValues = []string{
+	"DELIVERED", "CANCELLED", "CANCELED", "COMPLETED", "COMPLETE", "DONE", "FAILED",
+	"REJECTED", "EXPIRED", "CLOSED", "REFUNDED", "ARCHIVED", "DELETED", "RESOLVED",
+}
+
+// StatusEnumDocumentsTerminal checks that lifecycle enums document which of their states are terminal
+type StatusEnumDocumentsTerminal struct {
+	suffixes []string
+}
+
+// NewStatusEnumDocumentsTerminal creates a new instance of the StatusEnumDocumentsTerminal rule.
+// If suffixes is empty, DefaultStatusEnumSuffixes is used.
+func NewStatusEnumDocumentsTerminal(suffixes []string) *StatusEnumDocumentsTerminal {
+	if len(suffixes) == 0 {
+		suffixes = DefaultStatusEnumSuffixes
+	}
+	return &StatusEnumDocumentsTerminal{suffixes: suffixes}
+}
+
+// Name returns the rule name
+func (r *StatusEnumDocumentsTerminal) Name() string {
+	return "status-enum-documents-terminal"
+}
+
+// Description returns what this rule checks
+func (r *StatusEnumDocumentsTerminal) Description() string {
+	return "Status and state enums should document which of their values are terminal states (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *StatusEnumDocumentsTerminal) OptIn() bool {
+	return true
+}
+
+// Check validates that lifecycle enum descriptions mention their terminal states
+func (r *StatusEnumDocumentsTerminal) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") || def.Kind != ast.Enum {
+			continue
+		}
+
+		if !r.isStatusEnum(def.Name) || r.documentsTerminalStates(def.Description) {
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		message := fmt.Sprintf("Enum `%s` should document which states are terminal", def.Name)
+		if examples := r.terminalExamples(def); len(examples) > 0 {
+			message += fmt.Sprintf(" (e.g. %s)", strings.Join(examples, ", "))
+		}
+
+		errors = append(errors, types.LintError{
+			Message: message + ".",
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// isStatusEnum checks if an enum name ends with one of the configured suffixes
+func (r *StatusEnumDocumentsTerminal) isStatusEnum(name string) bool {
+	for _, suffix := range r.suffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// documentsTerminalStates checks if a description mentions terminal states
+func (r *StatusEnumDocumentsTerminal) documentsTerminalStates(description string) bool {
+	lower := strings.ToLower(description)
+	for _, keyword := range terminalStateKeywords {
+		if strings.Contains(lower, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// terminalExamples returns the enum values that look like terminal states
+func (r *StatusEnumDocumentsTerminal) terminalExamples(def *ast.Definition) []string {
+	var examples []string
+	for _, value := range def.EnumValues {
+		if contains(likelyTerminalValues, value.Name) {
+			examples = append(examples, value.Name)
+		}
+	}
+	return examples
+}
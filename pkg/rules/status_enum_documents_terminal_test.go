@@ -0,0 +1,77 @@
+package rules
+
+import "testing"
+
+func TestStatusEnumDocumentsTerminal(t *testing.T) {
+	rule := NewStatusEnumDocumentsTerminal(nil)
+
+	if !rule.OptIn() {
+		t.Error("Expected status-enum-documents-terminal to be opt-in")
+	}
+
+	t.Run("should flag status enums without terminal state documentation", func(t *testing.T) {
+		schema := `
+		"""Lifecycle of an order"""
+		enum OrderStatus {
+			PENDING
+			DELIVERED
+			CANCELLED
+		}
+
+		enum ConnectionState {
+			OPEN
+			IDLE
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "status-enum-documents-terminal") != 2 {
+			t.Errorf("Expected 2 errors, got %d", countRuleErrors(errors, "status-enum-documents-terminal"))
+		}
+
+		expectedMessages := []string{
+			"Enum `OrderStatus` should document which states are terminal (e.g. DELIVERED, CANCELLED).",
+			"Enum `ConnectionState` should document which states are terminal.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+
+	t.Run("should pass documented status enums and other enums", func(t *testing.T) {
+		schema := `
+		"""Lifecycle of an order. DELIVERED and CANCELLED are terminal."""
+		enum OrderStatus {
+			PENDING
+			DELIVERED
+			CANCELLED
+		}
+
+		enum Color {
+			RED
+			BLUE
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "status-enum-documents-terminal") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "status-enum-documents-terminal"))
+		}
+	})
+
+	t.Run("should use configured name suffixes", func(t *testing.T) {
+		schema := `
+		enum OrderPhase {
+			DONE
+		}
+
+		enum OrderStatus {
+			DONE
+		}
+		`
+		errors := runRule(t, NewStatusEnumDocumentsTerminal([]string{"Phase"}), schema)
+		if countRuleErrors(errors, "status-enum-documents-terminal") != 1 {
+			t.Errorf("Expected 1 error with custom suffixes, got %d", countRuleErrors(errors, "status-enum-documents-terminal"))
+		}
+	})
+}
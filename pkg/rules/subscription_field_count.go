@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultMaxSubscriptionFields is the limit used by NewSubscriptionFieldCount when none is configured
+const DefaultMaxSubscriptionFields = 15
+
+// SubscriptionFieldCount checks that the Subscription root stays small and, optionally, that its fields don't return lists
+type SubscriptionFieldCount struct {
+	limit      int
+	checkLists bool
+}
+
+// NewSubscriptionFieldCount creates a new instance of the SubscriptionFieldCount rule.
+// If limit is less than 1, DefaultMaxSubscriptionFields is used. When checkLists is set,
+// subscription fields returning lists are reported as well.
+func NewSubscriptionFieldCount(limit int, checkLists bool) *SubscriptionFieldCount {
+	if limit < 1 {
+		limit = DefaultMaxSubscriptionFields
+	}
+	return &SubscriptionFieldCount{limit: limit, checkLists: checkLists}
+}
+
+// Name returns the rule name
+func (r *SubscriptionFieldCount) Name() string {
+	return "subscription-field-count"
+}
+
+// Description returns what this rule checks
+func (r *SubscriptionFieldCount) Description() string {
+	description := fmt.Sprintf("The Subscription type should have at most %d fields", r.limit)
+	if r.checkLists {
+		description += ", and subscription fields should stream single items rather than lists"
+	}
+	return description + " (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *SubscriptionFieldCount) OptIn() bool {
+	return true
+}
+
+// Check validates the size of the Subscription type and the return types of its fields
+func (r *SubscriptionFieldCount) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	if schema.Subscription == nil {
+		return errors
+	}
+
+	var fields ast.FieldList
+	for _, field := range schema.Subscription.Fields {
+		// Skip introspection fields
+		if !strings.HasPrefix(field.Name, "__") {
+			fields = append(fields, field)
+		}
+	}
+
+	if len(fields) > r.limit {
+		errors = append(errors, r.newError(source,
+			fmt.Sprintf("Subscription type `%s` has %d fields, exceeding the maximum of %d; keep subscription roots small.",
+				schema.Subscription.Name, len(fields), r.limit),
+			schema.Subscription.Position))
+	}
+
+	if !r.checkLists {
+		return errors
+	}
+
+	for _, field := range fields {
+		if isListType(field.Type) {
+			errors = append(errors, r.newError(source,
+				fmt.Sprintf("Subscription field `%s` returns a list; subscriptions should stream single items.", field.Name),
+				field.Position))
+		}
+	}
+
+	return errors
+}
+
+// newError creates an error reported at position
+func (r *SubscriptionFieldCount) newError(source *ast.Source, message string, position *ast.Position) types.LintError {
+	line, column := 1, 1
+	if position != nil {
+		line = position.Line
+		column = position.Column
+	}
+
+	return types.LintError{
+		Message: message,
+		Location: types.Location{
+			Line:   line,
+			Column: column,
+			File:   source.Name,
+		},
+		Rule: r.Name(),
+	}
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSubscriptionFieldCount(t *testing.T) {
+	t.Run("should allow small subscription roots of single items", func(t *testing.T) {
+		rule := NewSubscriptionFieldCount(0, true)
+		schema := `
+		type Message {
+			id: ID!
+		}
+
+		type Query {
+			messages: [Message]
+		}
+
+		type Subscription {
+			messageAdded: Message
+			messageDeleted: ID
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "subscription-field-count") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag subscription roots over the limit", func(t *testing.T) {
+		rule := NewSubscriptionFieldCount(0, true)
+		var fields strings.Builder
+		for i := 0; i <= DefaultMaxSubscriptionFields; i++ {
+			fmt.Fprintf(&fields, "event%d: ID\n", i)
+		}
+		schema := `
+		type Query {
+			ping: Boolean
+		}
+
+		type Subscription {
+		` + fields.String() + `
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Subscription type `Subscription` has 16 fields, exceeding the maximum of 15; keep subscription roots small."
+		if countRuleErrors(errors, "subscription-field-count") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+
+		if errors := runRule(t, NewSubscriptionFieldCount(20, true), schema); countRuleErrors(errors, "subscription-field-count") > 0 {
+			t.Errorf("Expected no errors with a limit of 20, got %v", errors)
+		}
+	})
+
+	t.Run("should flag list subscription fields unless disabled", func(t *testing.T) {
+		schema := `
+		type Message {
+			id: ID!
+		}
+
+		type Query {
+			ping: Boolean
+		}
+
+		type Subscription {
+			messages: [Message!]!
+		}
+		`
+		errors := runRule(t, NewSubscriptionFieldCount(0, true), schema)
+		expectedMessage := "Subscription field `messages` returns a list; subscriptions should stream single items."
+		if countRuleErrors(errors, "subscription-field-count") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+
+		if errors := runRule(t, NewSubscriptionFieldCount(0, false), schema); countRuleErrors(errors, "subscription-field-count") > 0 {
+			t.Errorf("Expected no errors with the list check disabled, got %v", errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !NewSubscriptionFieldCount(0, true).OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
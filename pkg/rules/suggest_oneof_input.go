@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// minOneOfAlternatives is the number of `by*` fields that make an input look like a oneOf selector
+const minOneOfAlternatives = 2
+
+// SuggestOneOfInput suggests @oneOf for input objects that look like a choice between alternatives
+type SuggestOneOfInput struct{}
+
+// NewSuggestOneOfInput creates a new instance of the SuggestOneOfInput rule
+func NewSuggestOneOfInput() *SuggestOneOfInput {
+	return &SuggestOneOfInput{}
+}
+
+// Name returns the rule name
+func (r *SuggestOneOfInput) Name() string {
+	return "suggest-oneof-input"
+}
+
+// Description returns what this rule checks
+func (r *SuggestOneOfInput) Description() string {
+	return "Input objects whose fields are all nullable without defaults and that have 2 or more alternative `by*` fields (e.g. `byId`, `byEmail`) should be marked @oneOf (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *SuggestOneOfInput) OptIn() bool {
+	return true
+}
+
+// Check validates that oneOf-like input objects are marked @oneOf
+func (r *SuggestOneOfInput) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.InputObject || def.Directives.ForName("oneOf") != nil {
+			continue
+		}
+
+		if !r.looksLikeOneOf(def) {
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Input `%s` looks like a oneOf selector but isn't marked @oneOf.", def.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// looksLikeOneOf checks if every field is nullable without a default and enough fields are `by*` alternatives
+func (r *SuggestOneOfInput) looksLikeOneOf(def *ast.Definition) bool {
+	alternatives := 0
+	for _, field := range def.Fields {
+		if field.Type.NonNull || field.DefaultValue != nil {
+			return false
+		}
+		if r.isAlternativeName(field.Name) {
+			alternatives++
+		}
+	}
+	return alternatives >= minOneOfAlternatives
+}
+
+// isAlternativeName checks if a field name has the form `byX`, e.g. `byEmail`
+func (r *SuggestOneOfInput) isAlternativeName(name string) bool {
+	return len(name) > 2 && strings.HasPrefix(name, "by") && name[2] >= 'A' && name[2] <= 'Z'
+}
@@ -0,0 +1,60 @@
+package rules
+
+import "testing"
+
+func TestSuggestOneOfInput(t *testing.T) {
+	rule := NewSuggestOneOfInput()
+
+	t.Run("should allow @oneOf inputs and regular inputs", func(t *testing.T) {
+		schema := `
+		input UserLookupInput @oneOf {
+			byId: ID
+			byEmail: String
+		}
+
+		input UserFilterInput {
+			byName: String
+			byEmail: String
+			limit: Int = 10
+		}
+
+		input UserSearchInput {
+			byName: String
+			nickname: String
+		}
+
+		type Query {
+			user(lookup: UserLookupInput, filter: UserFilterInput, search: UserSearchInput): String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "suggest-oneof-input") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag oneOf-like inputs without @oneOf", func(t *testing.T) {
+		schema := `
+		input UserLookupInput {
+			byId: ID
+			byEmail: String
+			bySlug: String
+		}
+
+		type Query {
+			user(lookup: UserLookupInput!): String
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Input `UserLookupInput` looks like a oneOf selector but isn't marked @oneOf."
+		if countRuleErrors(errors, "suggest-oneof-input") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !rule.OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
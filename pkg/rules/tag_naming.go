@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultTagNamePattern is the lowercase kebab-case pattern used by NewTagNaming when none is configured
+var DefaultTagNamePattern = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+
+// TagNaming checks that the names of applied @tag directives follow a naming pattern and, optionally, an allowlist
+type TagNaming struct {
+	pattern *regexp.Regexp
+	allowed []string
+}
+
+// NewTagNaming creates a new instance of the TagNaming rule.
+// If pattern is nil, DefaultTagNamePattern is used. If allowed is not empty, only those tag names are accepted.
+func NewTagNaming(pattern *regexp.Regexp, allowed []string) *TagNaming {
+	if pattern == nil {
+		pattern = DefaultTagNamePattern
+	}
+	return &TagNaming{pattern: pattern, allowed: allowed}
+}
+
+// Name returns the rule name
+func (r *TagNaming) Name() string {
+	return "tag-naming"
+}
+
+// Description returns what this rule checks
+func (r *TagNaming) Description() string {
+	return "Names of applied @tag directives should be lowercase kebab-case, or match the configured pattern and allowlist"
+}
+
+// Check validates the names of applied @tag directives
+func (r *TagNaming) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+
+		errors = append(errors, r.checkDirectives(def.Directives, fmt.Sprintf("type `%s`", def.Name), source)...)
+
+		for _, field := range def.Fields {
+			// Skip introspection fields
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			errors = append(errors, r.checkDirectives(field.Directives, fmt.Sprintf("field `%s.%s`", def.Name, field.Name), source)...)
+
+			for _, arg := range field.Arguments {
+				errors = append(errors, r.checkDirectives(arg.Directives, fmt.Sprintf("argument `%s.%s(%s:)`", def.Name, field.Name, arg.Name), source)...)
+			}
+		}
+
+		for _, enumValue := range def.EnumValues {
+			errors = append(errors, r.checkDirectives(enumValue.Directives, fmt.Sprintf("enum value `%s.%s`", def.Name, enumValue.Name), source)...)
+		}
+	}
+
+	return errors
+}
+
+// checkDirectives validates the @tag directives applied to one schema element
+func (r *TagNaming) checkDirectives(directives ast.DirectiveList, subject string, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, directive := range directives.ForNames("tag") {
+		nameArg := directive.Arguments.ForName("name")
+		if nameArg == nil || nameArg.Value == nil || nameArg.Value.Kind != ast.StringValue {
+			continue
+		}
+		tag := nameArg.Value.Raw
+
+		var message string
+		switch {
+		case !r.pattern.MatchString(tag) && r.pattern == DefaultTagNamePattern:
+			message = fmt.Sprintf("Tag `%s` on %s should be lowercase kebab-case (e.g. `%s`).", tag, subject, r.toKebabCase(tag))
+		case !r.pattern.MatchString(tag):
+			message = fmt.Sprintf("Tag `%s` on %s should match the pattern `%s`.", tag, subject, r.pattern.String())
+		case len(r.allowed) > 0 && !contains(r.allowed, tag):
+			message = fmt.Sprintf("Tag `%s` on %s is not one of the allowed tags: `%s`.", tag, subject, strings.Join(r.allowed, "`, `"))
+		default:
+			continue
+		}
+
+		line, column := 1, 1
+		if directive.Position != nil {
+			line = directive.Position.Line
+			column = directive.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: message,
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// toKebabCase converts a tag name to lowercase kebab-case, e.g. "PartnerAPI_v2" becomes "partner-api-v2"
+func (r *TagNaming) toKebabCase(tag string) string {
+	var words []string
+	for _, part := range strings.FieldsFunc(tag, func(c rune) bool { return c == '_' || c == '-' || c == ' ' }) {
+		for _, word := range splitCamelCase(part) {
+			words = append(words, strings.ToLower(word))
+		}
+	}
+	return strings.Join(words, "-")
+}
@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"regexp"
+	"testing"
+)
+
+// tagDirective declares the Apollo Federation @tag directive
+const tagDirective = `
+	directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT | INTERFACE | UNION | ARGUMENT_DEFINITION | SCALAR | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION
+`
+
+func TestTagNaming(t *testing.T) {
+	t.Run("should allow kebab-case tags", func(t *testing.T) {
+		rule := NewTagNaming(nil, nil)
+		schema := tagDirective + `
+		type User @tag(name: "public") {
+			id: ID! @tag(name: "partner-api-v2")
+		}
+
+		type Query {
+			user(id: ID @tag(name: "internal")): User @tag(name: "public")
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "tag-naming") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag tags that are not kebab-case", func(t *testing.T) {
+		rule := NewTagNaming(nil, nil)
+		schema := tagDirective + `
+		type User @tag(name: "PartnerAPI_v2") {
+			id: ID!
+		}
+
+		enum Status {
+			ACTIVE @tag(name: "internal_only")
+		}
+
+		type Query {
+			users: [User] @tag(name: "Public")
+			status: Status
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Tag `Public` on field `Query.users` should be lowercase kebab-case (e.g. `public`).",
+			"Tag `PartnerAPI_v2` on type `User` should be lowercase kebab-case (e.g. `partner-api-v2`).",
+			"Tag `internal_only` on enum value `Status.ACTIVE` should be lowercase kebab-case (e.g. `internal-only`).",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "tag-naming") != 3 {
+			t.Errorf("Expected 3 errors, got %v", errors)
+		}
+	})
+
+	t.Run("should use the configured pattern and allowlist", func(t *testing.T) {
+		rule := NewTagNaming(regexp.MustCompile(`^[a-z]+$`), []string{"public", "internal"})
+		schema := tagDirective + `
+		type User {
+			id: ID! @tag(name: "beta")
+			name: String @tag(name: "partner-api")
+			email: String @tag(name: "internal")
+		}
+
+		type Query {
+			user: User
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessages := []string{
+			"Tag `beta` on field `User.id` is not one of the allowed tags: `public`, `internal`.",
+			"Tag `partner-api` on field `User.name` should match the pattern `^[a-z]+$`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+			}
+		}
+		if countRuleErrors(errors, "tag-naming") != 2 {
+			t.Errorf("Expected 2 errors, got %v", errors)
+		}
+	})
+}
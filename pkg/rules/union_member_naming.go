@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// DefaultUnionMemberPattern is the member name pattern used by NewUnionMemberNaming when none is configured.
+// It only rejects members without any uppercase letter.
+const DefaultUnionMemberPattern = `[A-Z]`
+
+// unionRootSuffixes are stripped from a union name to get its root noun, e.g. `CreateUserResult` becomes `CreateUser`
+var unionRootSuffixes = []string{"Result", "Response", "Payload", "Union"}
+
+// UnionMemberNaming checks that union members are named consistently relative to their union
+type UnionMemberNaming struct {
+	pattern      string
+	mutationLint *MutationLint
+}
+
+// NewUnionMemberNaming creates a new instance of the UnionMemberNaming rule.
+// The pattern is a regular expression that member names must match; `{union}` is replaced with the
+// union name and `{root}` with the union name without a Result, Response, Payload or Union suffix,
+// e.g. `^{root}` requires members of `CreateUserResult` to start with `CreateUser`. If pattern is
+// empty, DefaultUnionMemberPattern is used. It panics if pattern is not a valid regular expression.
+func NewUnionMemberNaming(pattern string) *UnionMemberNaming {
+	if pattern == "" {
+		pattern = DefaultUnionMemberPattern
+	}
+	regexp.MustCompile(expandUnionMemberPattern(pattern, "Union", "Union"))
+	return &UnionMemberNaming{pattern: pattern, mutationLint: NewMutationLint()}
+}
+
+// Name returns the rule name
+func (r *UnionMemberNaming) Name() string {
+	return "union-member-naming"
+}
+
+// Description returns what this rule checks
+func (r *UnionMemberNaming) Description() string {
+	return fmt.Sprintf("Union member names should match the pattern `%s` relative to their union; @error members are skipped (opt-in)", r.pattern)
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *UnionMemberNaming) OptIn() bool {
+	return true
+}
+
+// Check validates the member names of every union
+func (r *UnionMemberNaming) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def.Kind != ast.Union {
+			continue
+		}
+
+		root := def.Name
+		for _, suffix := range unionRootSuffixes {
+			if trimmed := strings.TrimSuffix(def.Name, suffix); trimmed != def.Name && trimmed != "" {
+				root = trimmed
+				break
+			}
+		}
+		pattern := regexp.MustCompile(expandUnionMemberPattern(r.pattern, def.Name, root))
+
+		for _, member := range def.Types {
+			if r.mutationLint.hasErrorDirective(schema.Types[member]) || pattern.MatchString(member) {
+				continue
+			}
+
+			line, column := 1, 1
+			if def.Position != nil {
+				line = def.Position.Line
+				column = def.Position.Column
+			}
+
+			errors = append(errors, types.LintError{
+				Message: fmt.Sprintf("Member `%s` of union `%s` doesn't follow the naming convention for this union's members.", member, def.Name),
+				Location: types.Location{
+					Line:   line,
+					Column: column,
+					File:   source.Name,
+				},
+				Rule: r.Name(),
+			})
+		}
+	}
+
+	return errors
+}
+
+// expandUnionMemberPattern replaces the `{union}` and `{root}` placeholders of a member name pattern
+func expandUnionMemberPattern(pattern, unionName, root string) string {
+	return strings.NewReplacer("{union}", regexp.QuoteMeta(unionName), "{root}", regexp.QuoteMeta(root)).Replace(pattern)
+}
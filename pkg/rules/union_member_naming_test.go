@@ -0,0 +1,74 @@
+package rules
+
+import "testing"
+
+func TestUnionMemberNaming(t *testing.T) {
+	t.Run("should only flag all-lowercase members by default", func(t *testing.T) {
+		rule := NewUnionMemberNaming("")
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type boom {
+			id: ID!
+		}
+
+		union CreateUserResult = User | boom
+
+		type Query {
+			user: User
+		}
+
+		type Mutation {
+			createUser: CreateUserResult
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Member `boom` of union `CreateUserResult` doesn't follow the naming convention for this union's members."
+		if countRuleErrors(errors, "union-member-naming") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should use the configured pattern and skip error members", func(t *testing.T) {
+		rule := NewUnionMemberNaming(`^{root}`)
+		schema := `
+		directive @error on OBJECT
+
+		type CreateUserSuccess {
+			id: ID!
+		}
+
+		type Boom {
+			id: ID!
+		}
+
+		type EmailTaken @error {
+			code: String!
+			message: String!
+		}
+
+		union CreateUserResult = CreateUserSuccess | Boom | EmailTaken
+
+		type Query {
+			ping: Boolean
+		}
+
+		type Mutation {
+			createUser: CreateUserResult
+		}
+		`
+		errors := runRule(t, rule, schema)
+		expectedMessage := "Member `Boom` of union `CreateUserResult` doesn't follow the naming convention for this union's members."
+		if countRuleErrors(errors, "union-member-naming") != 1 || !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s, got %v", expectedMessage, errors)
+		}
+	})
+
+	t.Run("should be opt-in", func(t *testing.T) {
+		if !NewUnionMemberNaming("").OptIn() {
+			t.Error("Expected rule to be opt-in")
+		}
+	})
+}
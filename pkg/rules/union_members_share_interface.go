@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// UnionMembersShareInterface checks that all members of a union implement at least one common interface
+type UnionMembersShareInterface struct{}
+
+// NewUnionMembersShareInterface creates a new instance of the UnionMembersShareInterface rule
+func NewUnionMembersShareInterface() *UnionMembersShareInterface {
+	return &UnionMembersShareInterface{}
+}
+
+// Name returns the rule name
+func (r *UnionMembersShareInterface) Name() string {
+	return "union-members-share-interface"
+}
+
+// Description returns what this rule checks
+func (r *UnionMembersShareInterface) Description() string {
+	return "All members of a union should implement a common interface for consistent client handling (opt-in)"
+}
+
+// OptIn reports that this rule only runs when explicitly enabled
+func (r *UnionMembersShareInterface) OptIn() bool {
+	return true
+}
+
+// Check validates that union members share at least one interface
+func (r *UnionMembersShareInterface) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") || def.Kind != ast.Union {
+			continue
+		}
+
+		shared, ok := r.sharedInterfaces(schema, def)
+		if !ok || len(shared) > 0 {
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: fmt.Sprintf("Union `%s` members do not share any common interface; consider a shared interface like `Node`.", def.Name),
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
+
+// sharedInterfaces computes the intersection of interfaces implemented by all union members.
+// It returns false if the union has no members or any member is not an object type.
+func (r *UnionMembersShareInterface) sharedInterfaces(schema *ast.Schema, union *ast.Definition) (map[string]bool, bool) {
+	if len(union.Types) == 0 {
+		return nil, false
+	}
+
+	var shared map[string]bool
+	for _, memberName := range union.Types {
+		member := schema.Types[memberName]
+		if member == nil || member.Kind != ast.Object {
+			return nil, false
+		}
+
+		implemented := make(map[string]bool)
+		for _, interfaceName := range member.Interfaces {
+			implemented[interfaceName] = true
+		}
+
+		if shared == nil {
+			shared = implemented
+			continue
+		}
+		for interfaceName := range shared {
+			if !implemented[interfaceName] {
+				delete(shared, interfaceName)
+			}
+		}
+	}
+
+	return shared, true
+}
@@ -0,0 +1,65 @@
+package rules
+
+import "testing"
+
+func TestUnionMembersShareInterface(t *testing.T) {
+	rule := NewUnionMembersShareInterface()
+
+	if !rule.OptIn() {
+		t.Error("Expected union-members-share-interface to be opt-in")
+	}
+
+	t.Run("should flag unions whose members share no interface", func(t *testing.T) {
+		schema := `
+		interface Node {
+			id: ID!
+		}
+
+		type Article implements Node {
+			id: ID!
+		}
+
+		type Video {
+			id: ID!
+		}
+
+		union Content = Article | Video
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "union-members-share-interface") != 1 {
+			t.Errorf("Expected 1 error, got %d", countRuleErrors(errors, "union-members-share-interface"))
+		}
+
+		expectedMessage := "Union `Content` members do not share any common interface; consider a shared interface like `Node`."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should pass unions whose members share an interface", func(t *testing.T) {
+		schema := `
+		interface Node {
+			id: ID!
+		}
+
+		interface Publishable {
+			title: String
+		}
+
+		type Article implements Node & Publishable {
+			id: ID!
+			title: String
+		}
+
+		type Video implements Node {
+			id: ID!
+		}
+
+		union Content = Article | Video
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "union-members-share-interface") > 0 {
+			t.Errorf("Expected no errors, got %d", countRuleErrors(errors, "union-members-share-interface"))
+		}
+	})
+}
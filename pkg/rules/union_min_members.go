@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// UnionMinMembers checks that unions have at least two member types
+type UnionMinMembers struct{}
+
+// NewUnionMinMembers creates a new instance of the UnionMinMembers rule
+func NewUnionMinMembers() *UnionMinMembers {
+	return &UnionMinMembers{}
+}
+
+// Name returns the rule name
+func (r *UnionMinMembers) Name() string {
+	return "union-min-members"
+}
+
+// Description returns what this rule checks
+func (r *UnionMinMembers) Description() string {
+	return "Unions must have at least two member types; empty and single-member unions are pointless and confuse code generators"
+}
+
+// Check validates that every union has at least two members
+func (r *UnionMinMembers) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") || def.Kind != ast.Union {
+			continue
+		}
+
+		var message string
+		switch len(def.Types) {
+		case 0:
+			message = fmt.Sprintf("Union `%s` has no members; a union should have at least two.", def.Name)
+		case 1:
+			message = fmt.Sprintf("Union `%s` has only one member; a union should have at least two.", def.Name)
+		default:
+			continue
+		}
+
+		line, column := 1, 1
+		if def.Position != nil {
+			line = def.Position.Line
+			column = def.Position.Column
+		}
+
+		errors = append(errors, types.LintError{
+			Message: message,
+			Location: types.Location{
+				Line:   line,
+				Column: column,
+				File:   source.Name,
+			},
+			Rule: r.Name(),
+		})
+	}
+
+	return errors
+}
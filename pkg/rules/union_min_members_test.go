@@ -0,0 +1,59 @@
+package rules
+
+import "testing"
+
+func TestUnionMinMembers(t *testing.T) {
+	rule := NewUnionMinMembers()
+
+	t.Run("should flag unions with no members", func(t *testing.T) {
+		schema := `
+		union SearchResult
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "union-min-members") != 1 {
+			t.Errorf("Expected 1 error for empty union, got %d", countRuleErrors(errors, "union-min-members"))
+		}
+
+		expectedMessage := "Union `SearchResult` has no members; a union should have at least two."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should flag unions with one member", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		union SearchResult = User
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "union-min-members") != 1 {
+			t.Errorf("Expected 1 error for single-member union, got %d", countRuleErrors(errors, "union-min-members"))
+		}
+
+		expectedMessage := "Union `SearchResult` has only one member; a union should have at least two."
+		if !containsError(errors, expectedMessage) {
+			t.Errorf("Expected error message: %s", expectedMessage)
+		}
+	})
+
+	t.Run("should pass unions with two members", func(t *testing.T) {
+		schema := `
+		type User {
+			id: ID!
+		}
+
+		type Post {
+			id: ID!
+		}
+
+		union SearchResult = User | Post
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "union-min-members") > 0 {
+			t.Errorf("Expected no errors for two-member union, got %d", countRuleErrors(errors, "union-min-members"))
+		}
+	})
+}
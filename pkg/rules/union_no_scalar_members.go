@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+)
+
+// UnionNoScalarMembers checks that union members, including members of directive-based virtual unions, are object types
+type UnionNoScalarMembers struct {
+	mutationLint *MutationLint
+}
+
+// NewUnionNoScalarMembers creates a new instance of the UnionNoScalarMembers rule
+func NewUnionNoScalarMembers() *UnionNoScalarMembers {
+	return &UnionNoScalarMembers{mutationLint: NewMutationLint()}
+}
+
+// Name returns the rule name
+func (r *UnionNoScalarMembers) Name() string {
+	return "union-no-scalar-members"
+}
+
+// Description returns what this rule checks
+func (r *UnionNoScalarMembers) Description() string {
+	return "Union members must be object types. The parser already rejects scalar and enum members of `union` definitions, so this mainly covers object types marked @responseUnion whose fields compose a virtual union"
+}
+
+// Check validates that every union member is an object type
+func (r *UnionNoScalarMembers) Check(schema *ast.Schema, source *ast.Source) []types.LintError {
+	var errors []types.LintError
+
+	for _, def := range schema.Types {
+		// Skip built-in and introspection types
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+
+		switch {
+		case def.Kind == ast.Union:
+			for _, member := range def.Types {
+				if memberDef := schema.Types[member]; memberDef != nil && memberDef.Kind != ast.Object {
+					errors = append(errors, r.newError(source, def.Name, member, def.Position))
+				}
+			}
+
+		case def.Kind == ast.Object && r.mutationLint.hasResponseUnionDirective(def):
+			// Each field of a virtual union holds one of its members
+			for _, field := range def.Fields {
+				memberDef := schema.Types[field.Type.Name()]
+				if memberDef != nil && (memberDef.Kind == ast.Scalar || memberDef.Kind == ast.Enum) {
+					errors = append(errors, r.newError(source, def.Name, memberDef.Name, field.Position))
+				}
+			}
+		}
+	}
+
+	return errors
+}
+
+// newError creates the error reported for a non-object union member
+func (r *UnionNoScalarMembers) newError(source *ast.Source, unionName, memberName string, position *ast.Position) types.LintError {
+	line, column := 1, 1
+	if position != nil {
+		line = position.Line
+		column = position.Column
+	}
+
+	return types.LintError{
+		Message: fmt.Sprintf("Union `%s` contains non-object member `%s`.", unionName, memberName),
+		Location: types.Location{
+			Line:   line,
+			Column: column,
+			File:   source.Name,
+		},
+		Rule: r.Name(),
+	}
+}
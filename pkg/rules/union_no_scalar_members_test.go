@@ -0,0 +1,75 @@
+package rules
+
+import "testing"
+
+func TestUnionNoScalarMembers(t *testing.T) {
+	rule := NewUnionNoScalarMembers()
+
+	responseUnionDirective := `
+	directive @responseUnion on UNION | OBJECT
+	`
+
+	t.Run("should allow object members", func(t *testing.T) {
+		schema := responseUnionDirective + `
+		type User {
+			id: ID!
+		}
+
+		type NotFoundError {
+			message: String!
+		}
+
+		union UserResult = User | NotFoundError
+
+		type DeleteUserResult @responseUnion {
+			user: User
+			notFound: NotFoundError
+		}
+
+		type Query {
+			user: UserResult
+			deleteUser: DeleteUserResult
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "union-no-scalar-members") > 0 {
+			t.Errorf("Expected no errors, got %v", errors)
+		}
+	})
+
+	t.Run("should flag scalar and enum members of virtual unions", func(t *testing.T) {
+		schema := responseUnionDirective + `
+		enum Status {
+			OK
+		}
+
+		type User {
+			id: ID!
+		}
+
+		type Result @responseUnion {
+			user: User
+			message: String
+			status: Status
+		}
+
+		type Query {
+			result: Result
+		}
+		`
+		errors := runRule(t, rule, schema)
+		if countRuleErrors(errors, "union-no-scalar-members") != 2 {
+			t.Errorf("Expected 2 errors for non-object members, got %d", countRuleErrors(errors, "union-no-scalar-members"))
+		}
+
+		expectedMessages := []string{
+			"Union `Result` contains non-object member `String`.",
+			"Union `Result` contains non-object member `Status`.",
+		}
+		for _, expectedMessage := range expectedMessages {
+			if !containsError(errors, expectedMessage) {
+				t.Errorf("Expected error message: %s", expectedMessage)
+			}
+		}
+	})
+}
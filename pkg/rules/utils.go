@@ -1,6 +1,58 @@
 package rules
 
-import "github.com/nishant-rn/gqlparser/v2/ast"
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/anirudhraja/gqllinter/pkg/types"
+	"github.com/nishant-rn/gqlparser/v2/ast"
+	"github.com/nishant-rn/gqlparser/v2/parser"
+)
+
+// parseFieldSelection parses a federation field set (the `fields` argument of @key, @requires or @provides)
+// by wrapping it in a fragment on typeName, and returns its top-level selections
+func parseFieldSelection(typeName, fields string) (ast.SelectionSet, error) {
+	query := fmt.Sprintf("fragment x on %s { %s }", typeName, fields)
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return nil, err
+	}
+	return doc.Fragments[0].SelectionSet, nil
+}
+
+// walkFieldSelection calls visit for every field selected from def, recursing into inline fragments and into the
+// sub-selections of composite fields. field is nil when the selected field doesn't exist on owner. The type names
+// of inline fragments on types that don't exist are passed to unknownType, which may be nil.
+func walkFieldSelection(schema *ast.Schema, def *ast.Definition, selectionSet ast.SelectionSet,
+	visit func(owner *ast.Definition, sel *ast.Field, field *ast.FieldDefinition), unknownType func(name string)) {
+	for _, sel := range selectionSet {
+		switch sel := sel.(type) {
+		case *ast.InlineFragment:
+			target := def
+			if sel.TypeCondition != "" {
+				target = schema.Types[sel.TypeCondition]
+			}
+			if target == nil {
+				if unknownType != nil {
+					unknownType(sel.TypeCondition)
+				}
+				continue
+			}
+			walkFieldSelection(schema, target, sel.SelectionSet, visit, unknownType)
+
+		case *ast.Field:
+			field := def.Fields.ForName(sel.Name)
+			visit(def, sel, field)
+			if field == nil || len(sel.SelectionSet) == 0 {
+				continue
+			}
+			if nested := schema.Types[field.Type.Name()]; nested != nil && nested.Kind != ast.Scalar && nested.Kind != ast.Enum {
+				walkFieldSelection(schema, nested, sel.SelectionSet, visit, unknownType)
+			}
+		}
+	}
+}
 
 // isNestedListType checks if a type is a nested list (list of lists)
 func isNestedListType(fieldType *ast.Type) bool {
@@ -77,3 +129,305 @@ func isPascalCase(s string) bool {
 
 	return true
 }
+
+// splitCamelCase splits a camelCase or PascalCase name into its words
+func splitCamelCase(name string) []string {
+	var words []string
+	start := 0
+	for i := 1; i < len(name); i++ {
+		prev, cur := name[i-1], name[i]
+		isBoundary := cur >= 'A' && cur <= 'Z' && !(prev >= 'A' && prev <= 'Z')
+		// Split the last capital of an acronym from the word it starts, e.g. "HTTPServer"
+		if cur >= 'A' && cur <= 'Z' && prev >= 'A' && prev <= 'Z' && i+1 < len(name) && name[i+1] >= 'a' && name[i+1] <= 'z' {
+			isBoundary = true
+		}
+		if isBoundary {
+			words = append(words, name[start:i])
+			start = i
+		}
+	}
+	if start < len(name) {
+		words = append(words, name[start:])
+	}
+	return words
+}
+
+// lastWord returns the last camelCase word of a name
+func lastWord(name string) string {
+	words := splitCamelCase(name)
+	if len(words) == 0 {
+		return ""
+	}
+	return words[len(words)-1]
+}
+
+// DefaultIrregularPlurals maps singular words to plurals that don't end in `s`
+var DefaultIrregularPlurals = map[string]string{
+	"child":     "children",
+	"person":    "people",
+	"man":       "men",
+	"woman":     "women",
+	"mouse":     "mice",
+	"foot":      "feet",
+	"tooth":     "teeth",
+	"criterion": "criteria",
+	"medium":    "media",
+	"datum":     "data",
+}
+
+// DefaultUncountableWords are nouns that name a single thing even though they may look plural
+var DefaultUncountableWords = []string{
+	"info", "information", "data", "metadata", "news", "equipment",
+	"series", "species", "feedback", "sheep", "fish",
+}
+
+// pluralDictionary decides whether names are plural using configurable irregular plurals and uncountable words
+type pluralDictionary struct {
+	irregularPlurals map[string]string
+	uncountable      map[string]bool
+}
+
+// newPluralDictionary creates a pluralDictionary, using the defaults for empty arguments
+func newPluralDictionary(irregularPlurals map[string]string, uncountableWords []string) pluralDictionary {
+	if len(irregularPlurals) == 0 {
+		irregularPlurals = DefaultIrregularPlurals
+	}
+	if len(uncountableWords) == 0 {
+		uncountableWords = DefaultUncountableWords
+	}
+	uncountable := make(map[string]bool)
+	for _, word := range uncountableWords {
+		uncountable[strings.ToLower(word)] = true
+	}
+	return pluralDictionary{irregularPlurals: irregularPlurals, uncountable: uncountable}
+}
+
+// isPlural checks if the last word of a name is plural, including irregular plurals and uncountable words
+func (d pluralDictionary) isPlural(name string) bool {
+	word := strings.ToLower(lastWord(name))
+	if d.uncountable[word] {
+		return true
+	}
+	for _, plural := range d.irregularPlurals {
+		if word == plural {
+			return true
+		}
+	}
+	return strings.HasSuffix(word, "s") &&
+		!strings.HasSuffix(word, "ss") &&
+		!strings.HasSuffix(word, "us") &&
+		!strings.HasSuffix(word, "is")
+}
+
+// isUncountable checks if the last word of a name is an uncountable noun
+func (d pluralDictionary) isUncountable(name string) bool {
+	return d.uncountable[strings.ToLower(lastWord(name))]
+}
+
+// pluralize suggests a plural name, using the irregular plural of the last word when there is one,
+// e.g. "userRole" becomes "userRoles"
+func (d pluralDictionary) pluralize(name string) string {
+	if d.isPlural(name) {
+		return name
+	}
+
+	word := lastWord(name)
+	if plural, ok := d.irregularPlurals[strings.ToLower(word)]; ok {
+		prefix := name[:len(name)-len(word)]
+		if prefix != "" {
+			plural = strings.ToUpper(plural[:1]) + plural[1:]
+		}
+		return prefix + plural
+	}
+
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !strings.ContainsRune("aeiou", rune(lower[len(lower)-2])):
+		return name[:len(name)-1] + "ies"
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "z"),
+		strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return name + "es"
+	default:
+		return name + "s"
+	}
+}
+
+// defaultPlurals is the plural dictionary for rules that don't configure their own
+var defaultPlurals = newPluralDictionary(nil, nil)
+
+// isPluralName checks if the last word of a camelCase name is plural, using the default dictionary
+func isPluralName(name string) bool {
+	return defaultPlurals.isPlural(name)
+}
+
+// pluralizeName pluralizes the last word of a camelCase name using the default dictionary
+func pluralizeName(name string) string {
+	return defaultPlurals.pluralize(name)
+}
+
+// isIdentifierName checks if a field or argument name refers to an identifier, e.g. `id`, `userId` or `ids`
+func isIdentifierName(name string) bool {
+	if name == "id" || name == "ids" {
+		return true
+	}
+	for _, suffix := range []string{"Id", "ID", "Ids", "IDs"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// reachableTypes returns the names of all types reachable from root by following field and argument types,
+// union members and interface implementers
+func reachableTypes(schema *ast.Schema, root *ast.Definition) map[string]bool {
+	reached := map[string]bool{root.Name: true}
+	queue := []*ast.Definition{root}
+
+	visit := func(name string) {
+		if reached[name] {
+			return
+		}
+		if def := schema.Types[name]; def != nil {
+			reached[name] = true
+			queue = append(queue, def)
+		}
+	}
+
+	for len(queue) > 0 {
+		def := queue[0]
+		queue = queue[1:]
+
+		for _, field := range def.Fields {
+			visit(field.Type.Name())
+			for _, arg := range field.Arguments {
+				visit(arg.Type.Name())
+			}
+		}
+
+		if def.Kind == ast.Union || def.Kind == ast.Interface {
+			for _, possible := range schema.GetPossibleTypes(def) {
+				visit(possible.Name)
+			}
+		}
+	}
+
+	return reached
+}
+
+// isPaginationHelperType checks if a type name is a Relay pagination helper: PageInfo, a Connection or an Edge
+func isPaginationHelperType(name string) bool {
+	return name == "PageInfo" || strings.HasSuffix(name, "Connection") || strings.HasSuffix(name, "Edge")
+}
+
+// nameLocation returns the location of a definition's name, spanning the whole name. The position of a
+// documented definition points at its description, so the name is looked up after it in the source text.
+// Without a position the location falls back to the start of the file, and the span is left out when
+// the name cannot be found.
+func nameLocation(source *ast.Source, position *ast.Position, name string) types.Location {
+	location := types.Location{Line: 1, Column: 1, File: source.Name}
+	if position == nil {
+		return location
+	}
+	location.Line = position.Line
+	location.Column = position.Column
+
+	input := source.Input
+	if position.Src != nil {
+		input = position.Src.Input
+	}
+	// Positions count runes, but the input is scanned by byte
+	offset := findNameToken(input, byteOffset(input, position.Start), name)
+	if offset < 0 {
+		return location
+	}
+
+	lineStart := strings.LastIndexByte(input[:offset], '\n') + 1
+	location.Line = strings.Count(input[:lineStart], "\n") + 1
+	location.Column = utf8.RuneCountInString(input[lineStart:offset]) + 1
+	location.EndLine = location.Line
+	location.EndColumn = location.Column + len(name) - 1
+	return location
+}
+
+// byteOffset converts a rune offset into input to a byte offset, or returns -1 if it lies past the end
+func byteOffset(input string, runes int) int {
+	if runes < 0 {
+		return -1
+	}
+	for offset := range input {
+		if runes == 0 {
+			return offset
+		}
+		runes--
+	}
+	if runes == 0 {
+		return len(input)
+	}
+	return -1
+}
+
+// findNameToken returns the byte offset of name at start, skipping a leading description, whitespace and
+// comments, or -1 if the next token is not name
+func findNameToken(input string, start int, name string) int {
+	if start < 0 || start > len(input) {
+		return -1
+	}
+	offset := start
+	switch {
+	case strings.HasPrefix(input[offset:], `"""`):
+		end := offset + 3
+		for {
+			i := strings.Index(input[end:], `"""`)
+			if i < 0 {
+				return -1
+			}
+			end += i
+			if input[end-1] != '\\' {
+				break
+			}
+			end += 3
+		}
+		offset = end + 3
+	case strings.HasPrefix(input[offset:], `"`):
+		end := offset + 1
+		for end < len(input) && input[end] != '"' && input[end] != '\n' {
+			if input[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(input) || input[end] != '"' {
+			return -1
+		}
+		offset = end + 1
+	}
+
+	for offset < len(input) {
+		switch input[offset] {
+		case ' ', '\t', '\n', '\r', ',':
+			offset++
+		case '#':
+			if i := strings.IndexByte(input[offset:], '\n'); i >= 0 {
+				offset += i
+			} else {
+				offset = len(input)
+			}
+		default:
+			rest := input[offset:]
+			if !strings.HasPrefix(rest, name) {
+				return -1
+			}
+			if len(rest) > len(name) && isNameByte(rest[len(name)]) {
+				return -1
+			}
+			return offset
+		}
+	}
+	return -1
+}
+
+// isNameByte checks if a byte can be part of a GraphQL name
+func isNameByte(b byte) bool {
+	return b == '_' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/nishant-rn/gqlparser/v2/ast"
 )
 
@@ -11,11 +13,15 @@ type LintError struct {
 	Rule     string   `json:"rule"`
 }
 
-// Location represents the position of an error in a file
+// Location represents the position of an error in a file.
+// EndLine and EndColumn optionally mark the last character of the reported span, e.g. a name;
+// when they are zero the error is reported at a single point.
 type Location struct {
-	Line   int    `json:"line"`
-	Column int    `json:"column"`
-	File   string `json:"file"`
+	Line      int    `json:"line"`
+	Column    int    `json:"column"`
+	EndLine   int    `json:"endLine,omitempty"`
+	EndColumn int    `json:"endColumn,omitempty"`
+	File      string `json:"file"`
 }
 
 // Rule interface that all linting rules must implement
@@ -29,3 +35,38 @@ type Rule interface {
 	// Check validates the schema and returns any errors found
 	Check(schema *ast.Schema, source *ast.Source) []LintError
 }
+
+// OptInRule is implemented by rules that are too opinionated to run by default.
+// Opt-in rules are only checked when explicitly enabled, e.g. via --rules.
+type OptInRule interface {
+	Rule
+
+	// OptIn reports whether the rule must be explicitly enabled to run
+	OptIn() bool
+}
+
+// Fixable is implemented by rules that can automatically fix the problems they report.
+type Fixable interface {
+	Rule
+
+	// Fix returns the contents of source with the rule's problems fixed.
+	// Fixing contents that have no problems must return them unchanged.
+	Fix(source *ast.Source) (string, error)
+}
+
+// MultiFileRule is implemented by rules that check definitions across every linted file.
+// CheckFiles runs once per lint run, after each file has been checked on its own.
+type MultiFileRule interface {
+	Rule
+
+	// CheckFiles validates the files that together make up the schema
+	CheckFiles(sources []*ast.Source) []LintError
+}
+
+// DatedRule is implemented by rules whose results depend on the current date.
+type DatedRule interface {
+	Rule
+
+	// SetNow sets the date the rule compares against; the zero time means the system date
+	SetNow(now time.Time)
+}